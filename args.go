@@ -30,6 +30,10 @@ const (
 	ArgActionType = "action-type"
 	// ArgCommandWait is a wait for a droplet to be created argument.
 	ArgCommandWait = "wait"
+	// ArgForceAfter is how long to wait for a graceful shutdown before powering off argument.
+	ArgForceAfter = "force-after"
+	// ArgWaitTimeout is how long to wait for an operation to complete argument.
+	ArgWaitTimeout = "wait-timeout"
 	// ArgDomainName is a domain name argument.
 	ArgDomainName = "domain-name"
 	// ArgDropletID is a droplet id argument.
@@ -40,22 +44,40 @@ const (
 	ArgImage = "image"
 	// ArgImageID is an image id argument.
 	ArgImageID = "image-id"
+	// ArgImageType is an image type argument.
+	ArgImageType = "type"
+	// ArgImageDistribution is an image distribution argument.
+	ArgImageDistribution = "distribution"
 	// ArgImagePublic is a public image argument.
 	ArgImagePublic = "public"
 	// ArgImageSlug is an image slug argment.
 	ArgImageSlug = "image-slug"
+	// ArgNoDefaultRecord is an argument to create a domain without an A record.
+	ArgNoDefaultRecord = "no-default-record"
 	// ArgIPAddress is an IP address argument.
 	ArgIPAddress = "ip-address"
+	// ArgDropletCount is a number of droplets to create argument.
+	ArgDropletCount = "count"
+	// ArgNameTemplate is a droplet name template argument.
+	ArgNameTemplate = "name-template"
+	// ArgDropletFromFile is a droplet spec file argument.
+	ArgDropletFromFile = "from-file"
+	// ArgDryRun is a dry run argument.
+	ArgDryRun = "dry-run"
 	// ArgDropletName is a droplet name argument.
 	ArgDropletName = "droplet-name"
 	// ArgResizeDisk is a resize disk argument.
 	ArgResizeDisk = "resize-disk"
+	// ArgResourceType is a resource type argument.
+	ArgResourceType = "resource-type"
 	// ArgSnapshotName is a snapshot name arugment.
 	ArgSnapshotName = "snapshot-name"
 	// ArgBackups is an enable backups argument.
 	ArgBackups = "enable-backups"
 	// ArgIPv6 is an enable IPv6 argument.
 	ArgIPv6 = "enable-ipv6"
+	// ArgNoPreflight is a skip pre-flight checks argument.
+	ArgNoPreflight = "no-preflight"
 	// ArgPrivateNetworking is an enable private networking argument.
 	ArgPrivateNetworking = "enable-private-networking"
 	// ArgRecordData is a record data argument.
@@ -70,8 +92,22 @@ const (
 	ArgRecordPriority = "record-priority"
 	// ArgRecordType is a record type argument.
 	ArgRecordType = "record-type"
+	// ArgRecordTypeFilter is a record type to filter a record list by.
+	ArgRecordTypeFilter = "type"
+	// ArgRecordNameFilter is a record name substring to filter a record list by.
+	ArgRecordNameFilter = "name"
 	// ArgRecordWeight is a record weight argument.
 	ArgRecordWeight = "record-weight"
+	// ArgTagResource is a tag resource argument.
+	ArgTagResource = "resource"
+	// ArgTokenStdin is a read token from stdin argument.
+	ArgTokenStdin = "token-stdin"
+	// ArgZoneFile is a zone file argument.
+	ArgZoneFile = "zone-file"
+	// ArgNeighborsAll is an argument to list every group of droplet neighbors.
+	ArgNeighborsAll = "all"
+	// ArgSort is a sort order argument.
+	ArgSort = "sort"
 	// ArgRegionSlug is a region slug argument.
 	ArgRegionSlug = "region"
 	// ArgSizeSlug is a size slug argument.
@@ -84,12 +120,16 @@ const (
 	ArgsSSHPort = "ssh-port"
 	// ArgsSSHAgentForwarding is a ssh argument.
 	ArgsSSHAgentForwarding = "ssh-agent-forwarding"
+	// ArgSSHCommand is a ssh argument.
+	ArgSSHCommand = "command"
 	// ArgsSSHPrivateIP is a ssh argument.
 	ArgsSSHPrivateIP = "ssh-private-ip"
 	// ArgUserData is a user data argument.
 	ArgUserData = "user-data"
 	// ArgUserDataFile is a user data file location argument.
 	ArgUserDataFile = "user-data-file"
+	// ArgUserDataVars is a user data template variables argument.
+	ArgUserDataVars = "user-data-vars"
 	// ArgImageName name is an image name argument.
 	ArgImageName = "image-name"
 	// ArgKey is a key argument.
@@ -108,6 +148,12 @@ const (
 	ArgNoHeader = "no-header"
 	// ArgPollTime is how long before the next poll argument.
 	ArgPollTime = "poll-timeout"
+	// ArgTagIdempotent is an argument to succeed if a tag already exists.
+	ArgTagIdempotent = "idempotent"
+	// ArgTagNames is a list of tag names argument.
+	ArgTagNames = "tag-names"
+	// ArgNoCreateTags is an argument to not create missing tags.
+	ArgNoCreateTags = "no-create-tags"
 	// ArgTagName is a tag name
 	ArgTagName = "tag-name"
 	//ArgTemplate is template format
@@ -122,9 +168,14 @@ const (
 	ArgVolumeDesc = "desc"
 	// ArgVolumeRegion is the region of a volume.
 	ArgVolumeRegion = "region"
+	// ArgVolumeSnapshot is the snapshot a volume is created from.
+	ArgVolumeSnapshot = "snapshot"
 	// ArgVolumeList is the IDs of many volumes.
 	ArgVolumeList = "volumes"
 
 	// ArgDeleteForce forces deletion actions
 	ArgDeleteForce = "force"
+
+	// ArgMaxConcurrency is the maximum number of concurrent operations argument.
+	ArgMaxConcurrency = "max-concurrency"
 )
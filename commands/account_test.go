@@ -14,8 +14,10 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
@@ -23,10 +25,11 @@ import (
 
 var testAccount = &do.Account{
 	Account: &godo.Account{
-		DropletLimit:  10,
-		Email:         "user@example.com",
-		UUID:          "1234",
-		EmailVerified: true,
+		DropletLimit:    10,
+		FloatingIPLimit: 3,
+		Email:           "user@example.com",
+		UUID:            "1234",
+		EmailVerified:   true,
 	},
 }
 
@@ -44,3 +47,46 @@ func TestAccountGet(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestAccountGetDefaultColumns(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.account.On("Get").Return(testAccount, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+
+		err := RunAccountGet(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Floating IP Limit")
+	})
+}
+
+func TestAccountGetFormat(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.account.On("Get").Return(testAccount, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgFormat, "DropletLimit,FloatingIPLimit")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunAccountGet(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "10\t3\n", buf.String())
+	})
+}
+
+func TestAccountGetJSON(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.account.On("Get").Return(testAccount, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(doctl.NSRoot, "output", "json")
+
+		err := RunAccountGet(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), `"floating_ip_limit": 3`)
+		assert.Contains(t, buf.String(), `"droplet_limit": 10`)
+	})
+}
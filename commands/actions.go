@@ -14,6 +14,9 @@ limitations under the License.
 package commands
 
 import (
+	"fmt"
+	"io"
+	"os"
 	"sort"
 	"strconv"
 	"time"
@@ -21,8 +24,12 @@ import (
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
 )
 
+// actionWaitProgress is where action wait reports status changes.
+var actionWaitProgress io.Writer = os.Stderr
+
 // Actions creates the action commands heirarchy.
 func Actions() *Command {
 	cmd := &Command{
@@ -50,13 +57,19 @@ func Actions() *Command {
 	cmdActionWait := CmdBuilder(cmd, RunCmdActionWait, "wait ACTIONID", "wait for action to complete", Writer,
 		aliasOpt("w"), displayerType(&action{}), docCategories("action"))
 	AddIntFlag(cmdActionWait, doctl.ArgPollTime, 5, "Re-poll time in seconds")
+	AddIntFlag(cmdActionWait, doctl.ArgWaitTimeout, 0, waitTimeoutUsage)
 
 	return cmd
 }
 
 // RunCmdActionList run action list.
 func RunCmdActionList(c *CmdConfig) error {
-	actions, err := c.Actions().List()
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	actions, err := c.Actions().List(opt)
 	if err != nil {
 		return err
 	}
@@ -209,32 +222,22 @@ func RunCmdActionWait(c *CmdConfig) error {
 		return err
 	}
 
-	a, err := actionWait(c, id, pollTime)
+	waitTimeout, err := c.Doit.GetInt(c.NS, doctl.ArgWaitTimeout)
+	if err != nil {
+		return err
+	}
+	a, err := pollAction(context.Background(), c.Actions(), id, waitTimeoutOrForever(waitTimeout), time.Duration(pollTime)*time.Second, actionWaitProgress)
 	if err != nil {
 		return err
 	}
 
-	return c.Display(&action{actions: do.Actions{*a}})
-}
-
-func actionWait(c *CmdConfig, actionID, pollTime int) (*do.Action, error) {
-	as := c.Actions()
-
-	var a *do.Action
-	var err error
-
-	for {
-		a, err = as.Get(actionID)
-		if err != nil {
-			return nil, err
-		}
-
-		if a.Status != "in-progress" {
-			break
-		}
+	if err := c.Display(&action{actions: do.Actions{*a}}); err != nil {
+		return err
+	}
 
-		time.Sleep(time.Duration(pollTime) * time.Second)
+	if a.Status == "errored" {
+		return fmt.Errorf("action %d errored", a.ID)
 	}
 
-	return a, nil
+	return nil
 }
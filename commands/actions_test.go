@@ -14,6 +14,7 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -38,7 +39,7 @@ func TestActionsCommand(t *testing.T) {
 
 func TestActionList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.actions.On("List").Return(testActionList, nil)
+		tm.actions.On("List", allPages).Return(testActionList, nil)
 
 		err := RunCmdActionList(config)
 		assert.NoError(t, err)
@@ -101,3 +102,59 @@ func Test_filterActions(t *testing.T) {
 		})
 	}
 }
+
+func TestActionWait(t *testing.T) {
+	awp := actionWaitProgress
+	defer func() { actionWaitProgress = awp }()
+
+	var progress bytes.Buffer
+	actionWaitProgress = &progress
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		inProgress := do.Action{Action: &godo.Action{ID: 1, Type: "resize", Status: godo.ActionInProgress}}
+		completed := do.Action{Action: &godo.Action{ID: 1, Type: "resize", Status: godo.ActionCompleted}}
+		tm.actions.On("Get", 1).Return(&inProgress, nil).Once()
+		tm.actions.On("Get", 1).Return(&completed, nil).Once()
+
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgPollTime, 0)
+
+		err := RunCmdActionWait(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "action 1 (resize): in-progress\naction 1 (resize): completed\n", progress.String())
+	})
+}
+
+func TestActionWaitErrored(t *testing.T) {
+	awp := actionWaitProgress
+	defer func() { actionWaitProgress = awp }()
+	actionWaitProgress = &bytes.Buffer{}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		errored := do.Action{Action: &godo.Action{ID: 1, Status: "errored"}}
+		tm.actions.On("Get", 1).Return(&errored, nil)
+
+		config.Args = append(config.Args, "1")
+
+		err := RunCmdActionWait(config)
+		assert.EqualError(t, err, "action 1 errored")
+	})
+}
+
+func TestActionWaitTimeout(t *testing.T) {
+	awp := actionWaitProgress
+	defer func() { actionWaitProgress = awp }()
+	actionWaitProgress = &bytes.Buffer{}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		inProgress := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionInProgress}}
+		tm.actions.On("Get", 1).Return(&inProgress, nil)
+
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgPollTime, 5)
+		config.Doit.Set(config.NS, doctl.ArgWaitTimeout, 1)
+
+		err := RunCmdActionWait(config)
+		assert.EqualError(t, err, "timed out waiting for action 1 after 1s")
+	})
+}
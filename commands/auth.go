@@ -17,9 +17,14 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
 
+	"github.com/digitalocean/doctl"
+
 	"golang.org/x/crypto/ssh/terminal"
 
 	"github.com/spf13/cobra"
@@ -42,6 +47,9 @@ var retrieveUserTokenFunc = func() (string, error) {
 	return reader.ReadString('\n')
 }
 
+// authStdin is where the token is read from with --token-stdin.
+var authStdin io.Reader = os.Stdin
+
 // UnknownSchemeError signifies an unknown HTTP scheme.
 type UnknownSchemeError struct {
 	Scheme string
@@ -63,22 +71,53 @@ func Auth() *Command {
 		},
 	}
 
-	cmdBuilderWithInit(cmd, RunAuthInit, "init", "initialize configuration", Writer, false, docCategories("auth"))
+	cmdAuthInit := cmdBuilderWithInit(cmd, RunAuthInit, "init", "initialize configuration", Writer, false, docCategories("auth"))
+	AddBoolFlag(cmdAuthInit, doctl.ArgTokenStdin, false, "Read the access token from stdin")
+
+	cmdBuilderWithInit(cmd, RunAuthList, "list", "list auth contexts", Writer, false,
+		aliasOpt("ls"), displayerType(&authContexts{}), docCategories("auth"))
 
 	return cmd
 }
 
 // RunAuthInit initializes the doctl config. Configuration is stored in $XDG_CONFIG_HOME/doctl. On Unix, if
 // XDG_CONFIG_HOME is not set, use $HOME/.config. On Windows use %APPDATA%/doctl/config.
+// With --context the token is stored under auth-contexts.<context>.
 func RunAuthInit(c *CmdConfig) error {
-	in, err := retrieveUserTokenFunc()
+	tokenStdin, err := c.Doit.GetBool(c.NS, doctl.ArgTokenStdin)
 	if err != nil {
-		return fmt.Errorf("unable to read DigitalOcean access token: %s", err)
+		return err
+	}
+
+	var in string
+	if tokenStdin {
+		b, err := ioutil.ReadAll(authStdin)
+		if err != nil {
+			return fmt.Errorf("unable to read DigitalOcean access token from stdin: %s", err)
+		}
+		in = string(b)
+	} else {
+		in, err = retrieveUserTokenFunc()
+		if err != nil {
+			return fmt.Errorf("unable to read DigitalOcean access token: %s", err)
+		}
 	}
 
 	token := strings.TrimSpace(in)
+	if token == "" {
+		return errors.New("DigitalOcean access token is empty")
+	}
 
-	viper.Set("access-token", string(token))
+	// with --context the token is saved as that auth context, leaving the
+	// default access token alone.
+	context := viper.GetString("context")
+	if context == "" || context == "default" {
+		viper.Set("access-token", string(token))
+	} else {
+		tokens := viper.GetStringMapString("auth-contexts")
+		tokens[context] = token
+		viper.Set("auth-contexts", tokens)
+	}
 
 	fmt.Fprintln(c.Out)
 	fmt.Fprint(c.Out, "Validating token: ")
@@ -99,3 +138,44 @@ func RunAuthInit(c *CmdConfig) error {
 
 	return writeConfig()
 }
+
+// RunAuthList lists the configured auth contexts.
+func RunAuthList(c *CmdConfig) error {
+	active := viper.GetString("context")
+	if active == "" {
+		active = "default"
+	}
+
+	contexts := []authContext{{
+		Name:    "default",
+		Current: active == "default",
+		Token:   viper.GetString("access-token"),
+	}}
+
+	tokens := viper.GetStringMapString("auth-contexts")
+	names := make([]string, 0, len(tokens))
+	for name := range tokens {
+		if name != "default" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		contexts = append(contexts, authContext{
+			Name:    name,
+			Current: name == active,
+			Token:   tokens[name],
+		})
+	}
+
+	return c.Display(&authContexts{contexts: contexts})
+}
+
+// maskToken hides all but the first and last four characters of token.
+func maskToken(token string) string {
+	if len(token) <= 8 {
+		return strings.Repeat("*", len(token))
+	}
+	return token[:4] + strings.Repeat("*", len(token)-8) + token[len(token)-4:]
+}
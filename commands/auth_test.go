@@ -14,18 +14,23 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
+	"errors"
 	"io"
 	"io/ioutil"
+	"strings"
 	"testing"
 
+	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestAuthCommand(t *testing.T) {
 	cmd := Auth()
 	assert.NotNil(t, cmd)
-	assertCommandNames(t, cmd, "init")
+	assertCommandNames(t, cmd, "init", "list")
 }
 
 func TestAuthInit(t *testing.T) {
@@ -50,6 +55,76 @@ func TestAuthInit(t *testing.T) {
 	})
 }
 
+func TestAuthInitTokenStdin(t *testing.T) {
+	as := authStdin
+	cfw := cfgFileWriter
+	defer func() {
+		authStdin = as
+		cfgFileWriter = cfw
+	}()
+
+	authStdin = strings.NewReader("valid-token\n")
+
+	written := false
+	cfgFileWriter = func() (io.WriteCloser, error) {
+		written = true
+		return &nopWriteCloser{Writer: ioutil.Discard}, nil
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.account.On("Get").Return(&do.Account{}, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgTokenStdin, true)
+
+		err := RunAuthInit(config)
+		assert.NoError(t, err)
+		assert.True(t, written)
+	})
+}
+
+func TestAuthInitTokenStdinInvalid(t *testing.T) {
+	as := authStdin
+	cfw := cfgFileWriter
+	defer func() {
+		authStdin = as
+		cfgFileWriter = cfw
+	}()
+
+	authStdin = strings.NewReader("invalid-token\n")
+
+	written := false
+	cfgFileWriter = func() (io.WriteCloser, error) {
+		written = true
+		return &nopWriteCloser{Writer: ioutil.Discard}, nil
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.account.On("Get").Return(nil, errors.New("unauthorized"))
+
+		config.Doit.Set(config.NS, doctl.ArgTokenStdin, true)
+
+		err := RunAuthInit(config)
+		assert.Error(t, err)
+		assert.False(t, written)
+	})
+}
+
+func TestAuthInitTokenStdinEmpty(t *testing.T) {
+	as := authStdin
+	defer func() {
+		authStdin = as
+	}()
+
+	authStdin = strings.NewReader("\n")
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgTokenStdin, true)
+
+		err := RunAuthInit(config)
+		assert.Error(t, err)
+	})
+}
+
 type nopWriteCloser struct {
 	io.Writer
 }
@@ -59,3 +134,98 @@ var _ io.WriteCloser = (*nopWriteCloser)(nil)
 func (d *nopWriteCloser) Close() error {
 	return nil
 }
+
+func withAuthContexts(t *testing.T, fn func()) {
+	keys := []string{"access-token", "auth-contexts", "context"}
+	saved := map[string]interface{}{}
+	for _, k := range keys {
+		saved[k] = viper.Get(k)
+	}
+	defer func() {
+		for _, k := range keys {
+			viper.Set(k, saved[k])
+		}
+	}()
+
+	viper.Set("access-token", "dflt0123456789abcd")
+	viper.Set("auth-contexts", map[string]interface{}{
+		"staging": "stag0123456789wxyz",
+		"ci":      "short",
+	})
+	viper.Set("context", "staging")
+
+	fn()
+}
+
+func TestAuthInitContext(t *testing.T) {
+	rtf := retrieveUserTokenFunc
+	cfw := cfgFileWriter
+	defer func() {
+		retrieveUserTokenFunc = rtf
+		cfgFileWriter = cfw
+	}()
+
+	retrieveUserTokenFunc = func() (string, error) {
+		return "work-token", nil
+	}
+
+	var buf bytes.Buffer
+	cfgFileWriter = func() (io.WriteCloser, error) { return &nopWriteCloser{Writer: &buf}, nil }
+
+	withAuthContexts(t, func() {
+		viper.Set("context", "work")
+
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.account.On("Get").Return(&do.Account{}, nil)
+
+			err := RunAuthInit(config)
+			assert.NoError(t, err)
+
+			assert.Equal(t, "dflt0123456789abcd", viper.GetString("access-token"))
+			assert.Equal(t, map[string]string{
+				"staging": "stag0123456789wxyz",
+				"ci":      "short",
+				"work":    "work-token",
+			}, viper.GetStringMapString("auth-contexts"))
+			assert.Contains(t, buf.String(), "work: work-token")
+		})
+	})
+}
+
+func TestAuthList(t *testing.T) {
+	withAuthContexts(t, func() {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			var buf bytes.Buffer
+			config.Out = &buf
+			config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+			err := RunAuthList(config)
+			assert.NoError(t, err)
+
+			expected := "default\tfalse\tdflt**********abcd\n" +
+				"ci\tfalse\t*****\n" +
+				"staging\ttrue\tstag**********wxyz\n"
+			assert.Equal(t, expected, strings.Replace(buf.String(), " ", "", -1))
+		})
+	})
+}
+
+func TestAuthListJSON(t *testing.T) {
+	withAuthContexts(t, func() {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			var buf bytes.Buffer
+			config.Out = &buf
+			config.Doit.Set(doctl.NSRoot, "output", "json")
+
+			err := RunAuthList(config)
+			assert.NoError(t, err)
+
+			out := buf.String()
+			assert.Contains(t, out, `"name": "staging"`)
+			assert.Contains(t, out, `"token": "[REDACTED]"`)
+			for _, s := range []string{"dflt0", "abcd", "stag0", "wxyz", "short"} {
+				assert.NotContains(t, out, s)
+			}
+		})
+	})
+}
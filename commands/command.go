@@ -25,6 +25,10 @@ type Command struct {
 
 	fmtCols []string
 
+	// completeResource is the type of resource the command's arguments
+	// complete to.
+	completeResource string
+
 	childCommands []*Command
 	IsIndex       bool
 }
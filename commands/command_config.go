@@ -50,6 +50,14 @@ func docCategories(categories ...string) cmdOption {
 	}
 }
 
+// completeArgs completes the command's arguments with the names of the
+// given resource type.
+func completeArgs(resource string) cmdOption {
+	return func(c *Command) {
+		c.completeResource = resource
+	}
+}
+
 // betaCmd tags commands as beta.
 func betaCmd() cmdOption {
 	return func(c *Command) {
@@ -15,12 +15,16 @@ package commands
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
+	"reflect"
 	"strings"
+	"text/template"
 
 	"github.com/digitalocean/doctl"
+	"gopkg.in/yaml.v2"
 )
 
 // Displayable is a displable entity. These are used for printing results.
@@ -29,6 +33,15 @@ type Displayable interface {
 	ColMap() map[string]string
 	KV() []map[string]interface{}
 	JSON(io.Writer) error
+	YAML(io.Writer) error
+	Raw() interface{}
+}
+
+// flatDisplayable is implemented by displayables that support the json-flat
+// output, where nested objects are replaced by top level keys derived from
+// them.
+type flatDisplayable interface {
+	FlatJSON() ([]map[string]interface{}, error)
 }
 
 type displayer struct {
@@ -51,13 +64,54 @@ func (d *displayer) Display() error {
 	switch output {
 	case "json":
 		return d.item.JSON(d.out)
+	case "json-flat":
+		f, ok := d.item.(flatDisplayable)
+		if !ok {
+			return fmt.Errorf("json-flat output is not supported for this command")
+		}
+
+		items, err := f.FlatJSON()
+		if err != nil {
+			return err
+		}
+
+		return writeJSON(items, d.out)
+	case "yaml":
+		return d.item.YAML(d.out)
 	case "text":
-		cols, err := handleColumns(d.ns, d.config)
+		cols, noHeader, err := handleColumns(d.ns, d.config)
 		if err != nil {
 			return err
 		}
 
-		return displayText(d.item, d.out, cols)
+		tmpl, err := d.config.GetString(d.ns, doctl.ArgTemplate)
+		if err != nil {
+			return err
+		}
+
+		if tmpl != "" {
+			if len(cols) > 0 {
+				return fmt.Errorf("--%s and --%s are mutually exclusive", doctl.ArgTemplate, doctl.ArgFormat)
+			}
+
+			return displayTemplate(d.item, d.out, tmpl)
+		}
+
+		return displayText(d.item, d.out, cols, noHeader)
+	case "jsonl":
+		cols, _, err := handleColumns(d.ns, d.config)
+		if err != nil {
+			return err
+		}
+
+		return displayJSONL(d.item, d.out, cols)
+	case "csv":
+		cols, noHeader, err := handleColumns(d.ns, d.config)
+		if err != nil {
+			return err
+		}
+
+		return displayCSV(d.item, d.out, cols, noHeader)
 	default:
 		return fmt.Errorf("unknown output type")
 	}
@@ -79,7 +133,190 @@ func writeJSON(item interface{}, w io.Writer) error {
 	return err
 }
 
-func displayText(item Displayable, out io.Writer, includeCols []string) error {
+// flattenJSON converts item to its json object form, drops the nested keys and
+// adds the derived top level keys in their place.
+func flattenJSON(item interface{}, nested []string, derived map[string]interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	m := map[string]interface{}{}
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+
+	for _, k := range nested {
+		delete(m, k)
+	}
+	for k, v := range derived {
+		m[k] = v
+	}
+
+	return m, nil
+}
+
+// writeYAML writes item as YAML. The item is round tripped through JSON first
+// so the keys match the ones used by the json output.
+func writeYAML(item interface{}, w io.Writer) error {
+	b, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+
+	if v == nil {
+		v = []interface{}{}
+	}
+
+	out, err := yaml.Marshal(yamlNumbers(v))
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(out)
+	return err
+}
+
+// yamlNumbers converts json.Number values to ints or floats so they aren't
+// quoted as strings in the YAML output.
+func yamlNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			t[k] = yamlNumbers(val)
+		}
+	case []interface{}:
+		for i, val := range t {
+			t[i] = yamlNumbers(val)
+		}
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		if f, err := t.Float64(); err == nil {
+			return f
+		}
+	}
+
+	return v
+}
+
+// displayTemplate executes a Go template against the raw items of a
+// displayable, which allows access to fields that aren't exposed as columns.
+func displayTemplate(item Displayable, out io.Writer, tmpl string) error {
+	t, err := template.New("output").Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("unable to parse template %q: %v", tmpl, err)
+	}
+
+	if err := t.Execute(out, item.Raw()); err != nil {
+		return fmt.Errorf("unable to execute template %q: %v", tmpl, err)
+	}
+
+	return nil
+}
+
+// displayJSONL writes one JSON record per line. If columns are selected,
+// each record holds only those columns, otherwise the full resource.
+func displayJSONL(item Displayable, out io.Writer, includeCols []string) error {
+	enc := json.NewEncoder(out)
+
+	if len(includeCols) > 0 {
+		colMap := item.ColMap()
+		for _, col := range includeCols {
+			if colMap[col] == "" {
+				return fmt.Errorf("unknown column %q", col)
+			}
+		}
+
+		for _, r := range item.KV() {
+			record := map[string]interface{}{}
+			for _, col := range includeCols {
+				record[col] = r[col]
+			}
+
+			if err := enc.Encode(record); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	v := reflect.ValueOf(item.Raw())
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Slice {
+		return enc.Encode(item.Raw())
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		if err := enc.Encode(v.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func displayCSV(item Displayable, out io.Writer, includeCols []string, noHeader bool) error {
+	w := csv.NewWriter(out)
+
+	cols := item.Cols()
+	if len(includeCols) > 0 && includeCols[0] != "" {
+		cols = includeCols
+	}
+
+	if !noHeader {
+		headers := []string{}
+		for _, k := range cols {
+			col := item.ColMap()[k]
+			if col == "" {
+				return fmt.Errorf("unknown column %q", k)
+			}
+
+			headers = append(headers, col)
+		}
+
+		if err := w.Write(headers); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range item.KV() {
+		record := []string{}
+		for _, col := range cols {
+			switch v := r[col].(type) {
+			case float64:
+				record = append(record, fmt.Sprintf("%f", v))
+			default:
+				record = append(record, fmt.Sprintf("%v", v))
+			}
+		}
+
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func displayText(item Displayable, out io.Writer, includeCols []string, noHeader bool) error {
 	w := newTabWriter(out)
 
 	cols := item.Cols()
@@ -87,7 +324,7 @@ func displayText(item Displayable, out io.Writer, includeCols []string) error {
 		cols = includeCols
 	}
 
-	if !hc.hideHeader {
+	if !noHeader {
 		headers := []string{}
 		for _, k := range cols {
 			col := item.ColMap()[k]
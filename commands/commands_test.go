@@ -109,6 +109,9 @@ var (
 	testFloatingIPList = do.FloatingIPs{testFloatingIP}
 )
 
+// allPages are the list options passed when every page should be fetched.
+var allPages *godo.ListOptions
+
 func assertCommandNames(t *testing.T, cmd *Command, expected ...string) {
 	var names []string
 
@@ -230,6 +233,11 @@ func (c *TestConfig) Set(ns, key string, val interface{}) {
 	c.v.Set(nskey, val)
 }
 
+func (c *TestConfig) IsSet(ns, key string) bool {
+	nskey := fmt.Sprintf("%s-%s", ns, key)
+	return c.v.IsSet(nskey)
+}
+
 func (c *TestConfig) GetString(ns, key string) (string, error) {
 	nskey := fmt.Sprintf("%s-%s", ns, key)
 	return c.v.GetString(nskey), nil
@@ -0,0 +1,279 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/doctl"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// completionCacheTTL is how long completion results are reused before the
+// API is queried again.
+var completionCacheTTL = 5 * time.Second
+
+// completionCacheDir is where completion results are cached.
+var completionCacheDir = func() string {
+	return filepath.Join(configHome(), "cache", "completion")
+}
+
+// completionLister lists the names a resource type completes to.
+type completionLister func(c *CmdConfig) ([]string, error)
+
+var completionListers = map[string]completionLister{
+	"droplet": func(c *CmdConfig) ([]string, error) {
+		list, err := c.Droplets().List(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var out []string
+		for _, d := range list {
+			out = append(out, strconv.Itoa(d.ID), d.Name)
+		}
+		return out, nil
+	},
+	"domain": func(c *CmdConfig) ([]string, error) {
+		list, err := c.Domains().List(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var out []string
+		for _, d := range list {
+			out = append(out, d.Name)
+		}
+		return out, nil
+	},
+	"floating-ip": func(c *CmdConfig) ([]string, error) {
+		list, err := c.FloatingIPs().List(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var out []string
+		for _, fip := range list {
+			out = append(out, fip.IP)
+		}
+		return out, nil
+	},
+	"ssh-key": func(c *CmdConfig) ([]string, error) {
+		list, err := c.Keys().List(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var out []string
+		for _, k := range list {
+			out = append(out, strconv.Itoa(k.ID), k.Fingerprint)
+		}
+		return out, nil
+	},
+	"tag": func(c *CmdConfig) ([]string, error) {
+		list, err := c.Tags().List(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var out []string
+		for _, t := range list {
+			out = append(out, t.Name)
+		}
+		return out, nil
+	},
+	"volume": func(c *CmdConfig) ([]string, error) {
+		list, err := c.Volumes().List(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var out []string
+		for _, v := range list {
+			out = append(out, v.ID)
+		}
+		return out, nil
+	},
+}
+
+// Completion creates the completion commands.
+func Completion() *Command {
+	cmd := &Command{
+		Command: &cobra.Command{
+			Use:   "completion",
+			Short: "completion commands",
+			Long:  "completion is used to generate shell completion scripts",
+		},
+	}
+
+	cmdCompletionBash := cmdBuilderWithInit(cmd, RunCompletionBash, "bash", "generate bash completion", Writer, false,
+		docCategories("completion"))
+	cmdCompletionBash.Long = `generate bash completion
+
+The script requires the bash-completion package. To load completions in the
+current shell run:
+
+  source <(doctl completion bash)
+
+To load them in every new shell, add that line to ~/.bashrc, or save the
+script to the bash-completion directory:
+
+  doctl completion bash > /etc/bash_completion.d/doctl`
+
+	cmdBuilderWithInit(cmd, RunCompletionResources, "resources <type>", "list resources for completion", Writer, false,
+		hiddenCmd())
+
+	return cmd
+}
+
+// RunCompletionBash outputs the bash completion script. Arguments of
+// commands taking resources complete to the live resources in the account.
+func RunCompletionBash(c *CmdConfig) error {
+	DoitCmd.BashCompletionFunction = bashCompletionFunction(DoitCmd)
+	return DoitCmd.GenBashCompletion(c.Out)
+}
+
+// RunCompletionResources lists the names of resources of a type, one per
+// line. It is called by the completion script, so errors such as a missing
+// access token result in no output rather than an error.
+func RunCompletionResources(c *CmdConfig) error {
+	if len(c.Args) != 1 {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+
+	resource := c.Args[0]
+	lister, ok := completionListers[resource]
+	if !ok {
+		return fmt.Errorf("unknown resource type %q", resource)
+	}
+
+	// results are cached per token and API, so switching --context or
+	// --access-token doesn't complete another account's resources.
+	token, err := doctl.AccessToken()
+	if err != nil {
+		return nil
+	}
+	key := sha256.Sum256([]byte(viper.GetString("api-url") + "\n" + token))
+	path := filepath.Join(completionCacheDir(), hex.EncodeToString(key[:8]), resource)
+	if fi, err := os.Stat(path); err == nil && time.Since(fi.ModTime()) < completionCacheTTL {
+		if b, err := ioutil.ReadFile(path); err == nil {
+			_, err = c.Out.Write(b)
+			return err
+		}
+	}
+
+	if c.Droplets == nil {
+		if err := c.initServices(c); err != nil {
+			return nil
+		}
+	}
+
+	names, err := lister(c)
+	if err != nil {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintln(&buf, name)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err == nil {
+		ioutil.WriteFile(path, buf.Bytes(), 0600)
+	}
+
+	_, err = io.Copy(c.Out, &buf)
+	return err
+}
+
+// bashCompletionFunction builds the custom bash completion function, which
+// completes resources for each command that was built with completeArgs.
+func bashCompletionFunction(root *Command) string {
+	commands := map[string][]string{}
+
+	var walk func(cmd *Command)
+	walk = func(cmd *Command) {
+		if cmd.completeResource != "" {
+			name := strings.Replace(cmd.CommandPath(), " ", "_", -1)
+			commands[cmd.completeResource] = append(commands[cmd.completeResource], name)
+		}
+
+		for _, child := range cmd.ChildCommands() {
+			walk(child)
+		}
+	}
+	walk(root)
+
+	var resources []string
+	for resource := range commands {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	var buf bytes.Buffer
+	// the global flags that select the account are passed on, so the
+	// resources listed are those of the command line being completed.
+	fmt.Fprintf(&buf, `__doctl_complete_resources()
+{
+    local out i
+    local -a account_flags=()
+    for ((i = 1; i < ${#words[@]}; i++)); do
+        case ${words[i]} in
+            --context=*|--access-token=*|--config=*|--api-url=*)
+                account_flags+=("${words[i]}")
+                ;;
+            --context|--access-token|-t|--config|-c|--api-url)
+                account_flags+=("${words[i]}" "${words[i+1]}")
+                ;;
+        esac
+    done
+
+    if out=$(%s "${account_flags[@]}" completion resources "$1" 2>/dev/null); then
+        COMPREPLY=( $(compgen -W "${out}" -- "$cur") )
+    fi
+}
+
+__custom_func() {
+    case ${last_command} in
+`, root.Name())
+
+	for _, resource := range resources {
+		names := commands[resource]
+		sort.Strings(names)
+		fmt.Fprintf(&buf, "        %s)\n", strings.Join(names, " | "))
+		fmt.Fprintf(&buf, "            __doctl_complete_resources %s\n", resource)
+		fmt.Fprintf(&buf, "            return\n")
+		fmt.Fprintf(&buf, "            ;;\n")
+	}
+
+	fmt.Fprintf(&buf, `        *)
+            ;;
+    esac
+}
+`)
+
+	return buf.String()
+}
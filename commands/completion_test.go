@@ -0,0 +1,104 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompletionCommand(t *testing.T) {
+	cmd := Completion()
+	assert.NotNil(t, cmd)
+	assertCommandNames(t, cmd, "bash", "resources")
+}
+
+func withCompletionCacheDir(t *testing.T, fn func(dir string)) {
+	dir, err := ioutil.TempDir("", "doctl-completion")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cacheDir := completionCacheDir
+	defer func() { completionCacheDir = cacheDir }()
+	completionCacheDir = func() string { return dir }
+
+	fn(dir)
+}
+
+func TestCompletionResources(t *testing.T) {
+	withCompletionCacheDir(t, func(dir string) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.droplets.On("List", allPages).Return(testDropletList, nil).Once()
+
+			var buf bytes.Buffer
+			config.Out = &buf
+			config.Args = append(config.Args, "droplet")
+
+			err := RunCompletionResources(config)
+			assert.NoError(t, err)
+			assert.Equal(t, "1\na-droplet\n3\nanother-droplet\n", buf.String())
+
+			buf.Reset()
+			err = RunCompletionResources(config)
+			assert.NoError(t, err)
+			assert.Equal(t, "1\na-droplet\n3\nanother-droplet\n", buf.String())
+		})
+	})
+}
+
+func TestCompletionResourcesCachedPerAccount(t *testing.T) {
+	withAuthContexts(t, func() {
+		withCompletionCacheDir(t, func(dir string) {
+			withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+				tm.droplets.On("List", allPages).Return(testDropletList, nil).Twice()
+
+				config.Out = ioutil.Discard
+				config.Args = append(config.Args, "droplet")
+
+				err := RunCompletionResources(config)
+				assert.NoError(t, err)
+
+				viper.Set("context", "ci")
+				err = RunCompletionResources(config)
+				assert.NoError(t, err)
+			})
+		})
+	})
+}
+
+func TestCompletionResourcesUnknown(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "spaceship")
+
+		err := RunCompletionResources(config)
+		assert.Error(t, err)
+	})
+}
+
+func Test_bashCompletionFunction(t *testing.T) {
+	root := &Command{Command: &cobra.Command{Use: "doctl"}}
+	CmdBuilder(root, RunDropletGet, "get", "get droplet", Writer, completeArgs("droplet"))
+	CmdBuilder(root, RunDropletList, "list", "list droplets", Writer)
+
+	f := bashCompletionFunction(root)
+	assert.Contains(t, f, "        doctl_get)\n            __doctl_complete_resources droplet\n")
+	assert.NotContains(t, f, "doctl_list")
+	assert.Contains(t, f, `$(doctl "${account_flags[@]}" completion resources "$1" 2>/dev/null)`)
+}
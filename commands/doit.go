@@ -17,17 +17,21 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"gopkg.in/yaml.v2"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
@@ -75,14 +79,17 @@ func init() {
 
 	DoitCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", "", "config file (default is $HOME/.config/doctl/config.yaml)")
 	DoitCmd.PersistentFlags().StringVarP(&Token, "access-token", "t", "", "API V2 Access Token")
-	DoitCmd.PersistentFlags().StringVarP(&Output, "output", "o", "text", "output format [text|json]")
+	DoitCmd.PersistentFlags().StringP("context", "", "", "authentication context to use from auth-contexts in the config (auth init saves its token under this name)")
+	DoitCmd.PersistentFlags().StringP("api-url", "", doctl.DefaultAPIURL, "base URL of the DigitalOcean API")
+	DoitCmd.PersistentFlags().StringVarP(&Output, "output", "o", "text", "output format [text|json|json-flat|jsonl|yaml|csv]")
 	DoitCmd.PersistentFlags().BoolVarP(&Verbose, "verbose", "v", false, "verbose output")
 	DoitCmd.PersistentFlags().BoolVarP(&Trace, "trace", "", false, "trace api access")
+	DoitCmd.PersistentFlags().IntP("http-retry-max", "", 3, "maximum number of retries for rate limited or failed api requests")
+	DoitCmd.PersistentFlags().IntP("page", "", 0, "fetch only this page of list results instead of every page")
+	DoitCmd.PersistentFlags().IntP("per-page", "", 0, "number of list results per page, implies --page 1 if --page is not set (max 200)")
 
 	viper.SetEnvPrefix("DIGITALOCEAN")
-	viper.BindEnv("access-token", "DIGITALOCEAN_ACCESS_TOKEN")
-	viper.BindPFlag("access-token", DoitCmd.PersistentFlags().Lookup("access-token"))
-	viper.BindPFlag("output", DoitCmd.PersistentFlags().Lookup("output"))
+	bindGlobalFlags(DoitCmd.PersistentFlags())
 	viper.BindEnv("enable-beta", "DIGITALOCEAN_ENABLE_BETA")
 
 	addCommands()
@@ -107,12 +114,171 @@ func initConfig() {
 		if err := viper.ReadInConfig(); err != nil {
 			log.Fatalln("reading initialization failed:", err)
 		}
+		checkConfigFile(cfgFile)
 	}
 
 	viper.SetDefault("output", "text")
 }
 
+// globalConfigKeys are the top level config keys that don't belong to a
+// command.
+var globalConfigKeys = []string{
+	"access-token", "api-url", "auth-contexts", "context", "enable-beta", "http-retry-max",
+	"output", "page", "per-page",
+}
+
+// checkConfigFile warns about problems in the config file. Unknown keys are
+// not fatal since the file may have been written by a newer doctl.
+func checkConfigFile(path string) {
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	warnings, err := configWarnings(f, DoitCmd.Command)
+	if err != nil {
+		warn(fmt.Sprintf("Unable to check configuration %q: %v", path, err))
+		return
+	}
+
+	for _, w := range warnings {
+		warn(fmt.Sprintf("Configuration %q: %s", path, w))
+	}
+}
+
+// configWarnings validates a YAML config against the keys known to root and
+// its sub commands.
+func configWarnings(r io.Reader, root *cobra.Command) ([]string, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg map[string]interface{}
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, err
+	}
+
+	known := knownConfigKeys(root)
+
+	var warnings, unknown []string
+	var walk func(prefix string, m map[string]interface{})
+	walk = func(prefix string, m map[string]interface{}) {
+		for k, v := range m {
+			key := strings.ToLower(k)
+			if prefix != "" {
+				key = prefix + "." + key
+			}
+
+			switch {
+			case key == "auth-contexts":
+				warnings = append(warnings, checkAuthContexts(v)...)
+			case key == "context":
+				if _, ok := v.(string); !ok && v != nil {
+					warnings = append(warnings, "context must be a string")
+				}
+			case known[key]:
+			default:
+				if sub, ok := yamlMap(v); ok {
+					walk(key, sub)
+				} else {
+					unknown = append(unknown, key)
+				}
+			}
+		}
+	}
+	walk("", cfg)
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		warnings = append(warnings, "unrecognized keys: "+strings.Join(unknown, ", "))
+	}
+	sort.Strings(warnings)
+
+	return warnings, nil
+}
+
+// checkAuthContexts checks that auth-contexts maps context names to tokens.
+func checkAuthContexts(v interface{}) []string {
+	contexts, ok := yamlMap(v)
+	if !ok {
+		return []string{"auth-contexts must map context names to access tokens"}
+	}
+
+	var warnings []string
+	for name, token := range contexts {
+		if t, ok := token.(string); !ok || t == "" {
+			warnings = append(warnings, fmt.Sprintf("auth context %q has no access token", name))
+		}
+	}
+
+	return warnings
+}
+
+// yamlMap converts a decoded YAML mapping to a map with string keys.
+func yamlMap(v interface{}) (map[string]interface{}, bool) {
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[fmt.Sprint(k)] = v
+	}
+	return out, true
+}
+
+// knownConfigKeys returns the config keys for the global settings and every
+// command flag, using the same names as flagName.
+func knownConfigKeys(root *cobra.Command) map[string]bool {
+	known := map[string]bool{}
+	for _, k := range globalConfigKeys {
+		known[k] = true
+	}
+	root.PersistentFlags().VisitAll(func(f *pflag.Flag) {
+		known[f.Name] = true
+	})
+
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		ns := cmdNS(cmd)
+		cmd.Flags().VisitAll(func(f *pflag.Flag) {
+			fn := fmt.Sprintf("%s.%s", ns, f.Name)
+			known[fn] = true
+			known[requiredKey(fn)] = true
+		})
+		for _, c := range cmd.Commands() {
+			walk(c)
+		}
+	}
+	for _, c := range root.Commands() {
+		walk(c)
+	}
+
+	return known
+}
+
+// bindGlobalFlags binds each global flag to viper along with a
+// DIGITALOCEAN_ prefixed environment variable, so a value is resolved from
+// the flag, then the environment, then the config file, then the default.
+func bindGlobalFlags(flags *pflag.FlagSet) {
+	flags.VisitAll(func(f *pflag.Flag) {
+		viper.BindEnv(f.Name, globalFlagEnv(f.Name))
+		viper.BindPFlag(f.Name, f)
+	})
+}
+
+// globalFlagEnv returns the environment variable that overrides a global flag.
+func globalFlagEnv(name string) string {
+	return "DIGITALOCEAN_" + strings.ToUpper(strings.Replace(name, "-", "_", -1))
+}
+
 func findConfig() (string, error) {
+	if cfgFile == "" {
+		cfgFile = viper.GetString("config")
+	}
 	if cfgFile != "" {
 		return cfgFile, nil
 	}
@@ -148,6 +314,7 @@ func Execute() {
 func addCommands() {
 	DoitCmd.AddCommand(Account())
 	DoitCmd.AddCommand(Auth())
+	DoitCmd.AddCommand(Completion())
 	DoitCmd.AddCommand(computeCmd())
 	DoitCmd.AddCommand(Version())
 }
@@ -172,6 +339,7 @@ func computeCmd() *Command {
 	cmd.AddCommand(Plugin())
 	cmd.AddCommand(Region())
 	cmd.AddCommand(Size())
+	cmd.AddCommand(Snapshot())
 	cmd.AddCommand(SSHKeys())
 	cmd.AddCommand(Tags())
 	cmd.AddCommand(Volume())
@@ -314,7 +482,7 @@ func NewCmdConfig(ns string, dc doctl.Config, out io.Writer, args []string, init
 		Args: args,
 
 		initServices: func(c *CmdConfig) error {
-			godoClient, err := c.Doit.GetGodoClient(Trace)
+			godoClient, err := c.Doit.GetGodoClient(viper.GetBool("trace"))
 			if err != nil {
 				return fmt.Errorf("unable to initialize DigitalOcean api client: %s", err)
 			}
@@ -360,6 +528,30 @@ func (c *CmdConfig) Display(d Displayable) error {
 	return dc.Display()
 }
 
+// ListOptions returns the page selected with --page and --per-page, or nil to
+// fetch every page. Only list commands should pass it on: lookups made on
+// behalf of other commands need every page.
+func (c *CmdConfig) ListOptions() (*godo.ListOptions, error) {
+	page, err := c.Doit.GetInt(doctl.NSRoot, "page")
+	if err != nil {
+		return nil, err
+	}
+
+	perPage, err := c.Doit.GetInt(doctl.NSRoot, "per-page")
+	if err != nil {
+		return nil, err
+	}
+
+	if page < 0 || perPage < 0 || perPage > 200 {
+		return nil, fmt.Errorf("--page must be positive and --per-page between 1 and 200")
+	}
+	if page == 0 && perPage == 0 {
+		return nil, nil
+	}
+
+	return &godo.ListOptions{Page: page, PerPage: perPage}, nil
+}
+
 // CmdBuilder builds a new command.
 func CmdBuilder(parent *Command, cr CmdRunner, cliText, desc string, out io.Writer, options ...cmdOption) *Command {
 	return cmdBuilderWithInit(parent, cr, cliText, desc, out, true, options...)
@@ -400,6 +592,7 @@ func cmdBuilderWithInit(parent *Command, cr CmdRunner, cliText, desc string, out
 			strings.Join(cols, ","))
 		AddStringFlag(c, doctl.ArgFormat, "", formatHelp)
 		AddBoolFlag(c, doctl.ArgNoHeader, false, "hide headers")
+		AddStringFlag(c, doctl.ArgTemplate, "", "Go template to format the output with (mutually exclusive with --format)")
 	}
 
 	return c
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/godo"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigWarnings(t *testing.T) {
+	f, err := os.Open("../testdata/malformed-config.yaml")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	warnings, err := configWarnings(f, DoitCmd.Command)
+	assert.NoError(t, err)
+
+	expected := []string{
+		`auth context "staging" has no access token`,
+		"unrecognized keys: acess-token, droplet.create.imgae, outptu",
+	}
+	assert.Equal(t, expected, warnings)
+}
+
+func TestConfigWarningsValid(t *testing.T) {
+	cfg := `
+access-token: 0123456789abcdef
+output: json
+auth-contexts:
+  ci: ci-token
+droplet:
+  create:
+    image: ubuntu-16-04-x64
+    ssh-keys: [1, 2]
+`
+	warnings, err := configWarnings(strings.NewReader(cfg), DoitCmd.Command)
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+}
+
+func TestConfigWarningsAuthContexts(t *testing.T) {
+	cfg := "auth-contexts: ci-token\ncontext: [ci]\n"
+
+	warnings, err := configWarnings(strings.NewReader(cfg), DoitCmd.Command)
+	assert.NoError(t, err)
+
+	expected := []string{
+		"auth-contexts must map context names to access tokens",
+		"context must be a string",
+	}
+	assert.Equal(t, expected, warnings)
+}
+
+func TestConfigWarningsInvalidYAML(t *testing.T) {
+	_, err := configWarnings(strings.NewReader("output: [json"), DoitCmd.Command)
+	assert.Error(t, err)
+}
+
+func TestGlobalFlagEnv(t *testing.T) {
+	assert.Equal(t, "DIGITALOCEAN_OUTPUT", globalFlagEnv("output"))
+	assert.Equal(t, "DIGITALOCEAN_HTTP_RETRY_MAX", globalFlagEnv("http-retry-max"))
+}
+
+func TestGlobalFlagPrecedence(t *testing.T) {
+	flag := DoitCmd.PersistentFlags().Lookup("output")
+	defer func() {
+		flag.Value.Set(flag.DefValue)
+		flag.Changed = false
+		os.Unsetenv("DIGITALOCEAN_OUTPUT")
+		viper.ReadConfig(strings.NewReader(""))
+	}()
+
+	assert.Equal(t, "text", viper.GetString("output"))
+
+	viper.SetConfigType("yaml")
+	assert.NoError(t, viper.ReadConfig(strings.NewReader("output: yaml\n")))
+	assert.Equal(t, "yaml", viper.GetString("output"))
+
+	os.Setenv("DIGITALOCEAN_OUTPUT", "json")
+	assert.Equal(t, "json", viper.GetString("output"))
+
+	assert.NoError(t, DoitCmd.PersistentFlags().Set("output", "csv"))
+	assert.Equal(t, "csv", viper.GetString("output"))
+}
+
+func TestCmdConfigListOptions(t *testing.T) {
+	cases := []struct {
+		page, perPage int
+		opt           *godo.ListOptions
+		err           string
+	}{
+		{},
+		{page: 2, opt: &godo.ListOptions{Page: 2}},
+		{perPage: 50, opt: &godo.ListOptions{PerPage: 50}},
+		{page: -1, err: "--page must be positive and --per-page between 1 and 200"},
+		{perPage: 201, err: "--page must be positive and --per-page between 1 and 200"},
+	}
+
+	for _, c := range cases {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			config.Doit.Set(doctl.NSRoot, "page", c.page)
+			config.Doit.Set(doctl.NSRoot, "per-page", c.perPage)
+
+			opt, err := config.ListOptions()
+			if c.err != "" {
+				assert.EqualError(t, err, c.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.opt, opt)
+		})
+	}
+}
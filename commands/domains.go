@@ -16,7 +16,9 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
+	"strings"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
@@ -38,15 +40,17 @@ func Domain() *Command {
 
 	cmdDomainCreate := CmdBuilder(cmd, RunDomainCreate, "create <domain>", "create domain", Writer,
 		aliasOpt("c"), displayerType(&domain{}), docCategories("domain"))
-	AddStringFlag(cmdDomainCreate, doctl.ArgIPAddress, "", "IP address", requiredOpt())
+	AddStringFlag(cmdDomainCreate, doctl.ArgIPAddress, "", "IP address for the default @ A record (required unless --no-default-record is set)")
+	AddBoolFlag(cmdDomainCreate, doctl.ArgNoDefaultRecord, false, "Create the domain without a default @ A record")
 
 	CmdBuilder(cmd, RunDomainList, "list", "list domains", Writer,
 		aliasOpt("ls"), displayerType(&domain{}), docCategories("domain"))
 
 	CmdBuilder(cmd, RunDomainGet, "get <domain>", "get domain", Writer,
-		aliasOpt("g"), displayerType(&domain{}), docCategories("domain"))
+		aliasOpt("g"), displayerType(&domain{}), docCategories("domain"), completeArgs("domain"))
 
-	CmdBuilder(cmd, RunDomainDelete, "delete <domain>", "delete domain", Writer, aliasOpt("g"))
+	CmdBuilder(cmd, RunDomainDelete, "delete <domain>", "delete domain", Writer, aliasOpt("g"),
+		completeArgs("domain"))
 
 	cmdRecord := &Command{
 		Command: &cobra.Command{
@@ -60,6 +64,8 @@ func Domain() *Command {
 	cmdRecordList := CmdBuilder(cmdRecord, RunRecordList, "list <domain>", "list records", Writer,
 		aliasOpt("ls"), displayerType(&domainRecord{}), docCategories("domain"))
 	AddStringFlag(cmdRecordList, doctl.ArgDomainName, "", "Domain name")
+	AddStringFlag(cmdRecordList, doctl.ArgRecordTypeFilter, "", "Only list records of this type, e.g. A or MX")
+	AddStringFlag(cmdRecordList, doctl.ArgRecordNameFilter, "", "Only list records whose name contains this")
 
 	cmdRecordCreate := CmdBuilder(cmdRecord, RunRecordCreate, "create <domain>", "create record", Writer,
 		aliasOpt("c"), displayerType(&domainRecord{}), docCategories("domain"))
@@ -83,6 +89,11 @@ func Domain() *Command {
 	AddIntFlag(cmdRecordUpdate, doctl.ArgRecordPort, 0, "Record port")
 	AddIntFlag(cmdRecordUpdate, doctl.ArgRecordWeight, 0, "Record weight")
 
+	cmdRecordImport := CmdBuilder(cmdRecord, RunRecordImport, "import <domain>", "import records from a zone file", Writer,
+		displayerType(&domainRecord{}), docCategories("domain"), completeArgs("domain"))
+	AddStringFlag(cmdRecordImport, doctl.ArgZoneFile, "", "Path to a BIND zone file", requiredOpt())
+	AddBoolFlag(cmdRecordImport, doctl.ArgDryRun, false, "Print the records from --zone-file without creating them")
+
 	return cmd
 }
 
@@ -95,11 +106,24 @@ func RunDomainCreate(c *CmdConfig) error {
 
 	ds := c.Domains()
 
-	ipAddress, err := c.Doit.GetString(c.NS, "ip-address")
+	ipAddress, err := c.Doit.GetString(c.NS, doctl.ArgIPAddress)
+	if err != nil {
+		return err
+	}
+
+	noDefaultRecord, err := c.Doit.GetBool(c.NS, doctl.ArgNoDefaultRecord)
 	if err != nil {
 		return err
 	}
 
+	// The API only creates the default A record when an IP address is sent.
+	switch {
+	case noDefaultRecord && ipAddress != "":
+		return fmt.Errorf("--%s can't be combined with --%s", doctl.ArgIPAddress, doctl.ArgNoDefaultRecord)
+	case !noDefaultRecord && ipAddress == "":
+		return fmt.Errorf("--%s is required unless --%s is set", doctl.ArgIPAddress, doctl.ArgNoDefaultRecord)
+	}
+
 	req := &godo.DomainCreateRequest{
 		Name:      domainName,
 		IPAddress: ipAddress,
@@ -118,7 +142,12 @@ func RunDomainList(c *CmdConfig) error {
 
 	ds := c.Domains()
 
-	domains, err := ds.List()
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	domains, err := ds.List(opt)
 	if err != nil {
 		return err
 	}
@@ -179,11 +208,40 @@ func RunRecordList(c *CmdConfig) error {
 		return errors.New("domain name is missing")
 	}
 
-	list, err := ds.Records(name)
+	rType, err := c.Doit.GetString(c.NS, doctl.ArgRecordTypeFilter)
+	if err != nil {
+		return err
+	}
+
+	rName, err := c.Doit.GetString(c.NS, doctl.ArgRecordNameFilter)
+	if err != nil {
+		return err
+	}
+
+	opt, err := c.ListOptions()
 	if err != nil {
 		return err
 	}
 
+	list, err := ds.Records(name, opt)
+	if err != nil {
+		return err
+	}
+
+	if rType != "" || rName != "" {
+		var filtered do.DomainRecords
+		for _, r := range list {
+			if rType != "" && !strings.EqualFold(r.Type, rType) {
+				continue
+			}
+			if rName != "" && !strings.Contains(strings.ToLower(r.Name), strings.ToLower(rName)) {
+				continue
+			}
+			filtered = append(filtered, r)
+		}
+		list = filtered
+	}
+
 	items := &domainRecord{domainRecords: list}
 	return c.Display(items)
 
@@ -279,7 +337,8 @@ func RunRecordDelete(c *CmdConfig) error {
 	return nil
 }
 
-// RunRecordUpdate updates a domain record.
+// RunRecordUpdate updates a domain record. Only the fields provided as flags
+// are changed; the rest are taken from the existing record.
 func RunRecordUpdate(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
@@ -293,50 +352,110 @@ func RunRecordUpdate(c *CmdConfig) error {
 		return err
 	}
 
-	rType, err := c.Doit.GetString(c.NS, doctl.ArgRecordType)
+	existing, err := ds.Record(domainName, recordID)
 	if err != nil {
 		return err
 	}
 
-	rName, err := c.Doit.GetString(c.NS, doctl.ArgRecordName)
+	drcr := &godo.DomainRecordEditRequest{
+		Type:     existing.Type,
+		Name:     existing.Name,
+		Data:     existing.Data,
+		Priority: existing.Priority,
+		Port:     existing.Port,
+		Weight:   existing.Weight,
+	}
+
+	stringFields := map[string]*string{
+		doctl.ArgRecordType: &drcr.Type,
+		doctl.ArgRecordName: &drcr.Name,
+		doctl.ArgRecordData: &drcr.Data,
+	}
+	for arg, field := range stringFields {
+		if !c.Doit.IsSet(c.NS, arg) {
+			continue
+		}
+		if *field, err = c.Doit.GetString(c.NS, arg); err != nil {
+			return err
+		}
+	}
+
+	intFields := map[string]*int{
+		doctl.ArgRecordPriority: &drcr.Priority,
+		doctl.ArgRecordPort:     &drcr.Port,
+		doctl.ArgRecordWeight:   &drcr.Weight,
+	}
+	for arg, field := range intFields {
+		if !c.Doit.IsSet(c.NS, arg) {
+			continue
+		}
+		if *field, err = c.Doit.GetInt(c.NS, arg); err != nil {
+			return err
+		}
+	}
+
+	r, err := ds.EditRecord(domainName, recordID, drcr)
 	if err != nil {
 		return err
 	}
 
-	rData, err := c.Doit.GetString(c.NS, doctl.ArgRecordData)
+	item := &domainRecord{domainRecords: do.DomainRecords{*r}}
+	return c.Display(item)
+}
+
+// RunRecordImport creates domain records from a BIND zone file.
+func RunRecordImport(c *CmdConfig) error {
+	if len(c.Args) != 1 {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+	domainName := c.Args[0]
+
+	zoneFile, err := c.Doit.GetString(c.NS, doctl.ArgZoneFile)
 	if err != nil {
 		return err
 	}
 
-	rPriority, err := c.Doit.GetInt(c.NS, doctl.ArgRecordPriority)
+	dryRun, err := c.Doit.GetBool(c.NS, doctl.ArgDryRun)
 	if err != nil {
 		return err
 	}
 
-	rPort, err := c.Doit.GetInt(c.NS, doctl.ArgRecordPort)
+	f, err := os.Open(zoneFile)
 	if err != nil {
 		return err
 	}
+	defer f.Close()
 
-	rWeight, err := c.Doit.GetInt(c.NS, doctl.ArgRecordWeight)
+	reqs, skipped, err := parseZoneFile(f, domainName)
 	if err != nil {
-		return err
+		return fmt.Errorf("unable to parse zone file: %v", err)
 	}
 
-	drcr := &godo.DomainRecordEditRequest{
-		Type:     rType,
-		Name:     rName,
-		Data:     rData,
-		Priority: rPriority,
-		Port:     rPort,
-		Weight:   rWeight,
+	for _, s := range skipped {
+		notice(fmt.Sprintf("skipping %s record %q on line %d", s.Type, s.Name, s.Line))
 	}
 
-	r, err := ds.EditRecord(domainName, recordID, drcr)
-	if err != nil {
-		return err
+	var records do.DomainRecords
+	if dryRun {
+		for _, req := range reqs {
+			records = append(records, do.DomainRecord{DomainRecord: &godo.DomainRecord{
+				Type: req.Type, Name: req.Name, Data: req.Data,
+				Priority: req.Priority, Port: req.Port, Weight: req.Weight,
+			}})
+		}
+		return c.Display(&domainRecord{domainRecords: records})
 	}
 
-	item := &domainRecord{domainRecords: do.DomainRecords{*r}}
-	return c.Display(item)
+	ds := c.Domains()
+	for _, req := range reqs {
+		r, err := ds.CreateRecord(domainName, req)
+		if err != nil {
+			return fmt.Errorf("unable to create %s record %q after creating %d record(s): %v",
+				req.Type, req.Name, len(records), err)
+		}
+		records = append(records, *r)
+	}
+
+	notice(fmt.Sprintf("created %d record(s), skipped %d", len(records), len(skipped)))
+	return c.Display(&domainRecord{domainRecords: records})
 }
@@ -14,12 +14,16 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -49,9 +53,35 @@ func TestDomainsCreate(t *testing.T) {
 	})
 }
 
+func TestDomainsCreateNoDefaultRecord(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcr := &godo.DomainCreateRequest{Name: "example.com"}
+		tm.domains.On("Create", dcr).Return(&testDomain, nil)
+
+		config.Args = append(config.Args, testDomain.Name)
+		config.Doit.Set(config.NS, doctl.ArgNoDefaultRecord, true)
+		err := RunDomainCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDomainsCreateIPAddressFlags(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, testDomain.Name)
+
+		err := RunDomainCreate(config)
+		assert.EqualError(t, err, "--ip-address is required unless --no-default-record is set")
+
+		config.Doit.Set(config.NS, doctl.ArgIPAddress, "127.0.0.1")
+		config.Doit.Set(config.NS, doctl.ArgNoDefaultRecord, true)
+		err = RunDomainCreate(config)
+		assert.EqualError(t, err, "--ip-address can't be combined with --no-default-record")
+	})
+}
+
 func TestDomainsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.domains.On("List").Return(testDomainList, nil)
+		tm.domains.On("List", allPages).Return(testDomainList, nil)
 
 		err := RunDomainList(config)
 		assert.NoError(t, err)
@@ -95,7 +125,7 @@ func TestDomainsGet_RequiredArguments(t *testing.T) {
 
 func TestRecordsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.domains.On("Records", "example.com").Return(testRecordList, nil)
+		tm.domains.On("Records", "example.com", allPages).Return(testRecordList, nil)
 
 		config.Args = append(config.Args, "example.com")
 
@@ -104,6 +134,49 @@ func TestRecordsList(t *testing.T) {
 	})
 }
 
+func TestRecordListFilters(t *testing.T) {
+	newRecord := func(id int, rType, name string) do.DomainRecord {
+		return do.DomainRecord{DomainRecord: &godo.DomainRecord{ID: id, Type: rType, Name: name}}
+	}
+	records := do.DomainRecords{
+		newRecord(1, "A", "www"),
+		newRecord(2, "A", "api"),
+		newRecord(3, "AAAA", "www"),
+		newRecord(4, "MX", "@"),
+		newRecord(5, "CNAME", "www-old"),
+		newRecord(6, "TXT", "@"),
+	}
+
+	cases := []struct {
+		rType, name string
+		ids         []string
+	}{
+		{rType: "A", ids: []string{"1", "2"}},
+		{rType: "mx", ids: []string{"4"}},
+		{name: "WWW", ids: []string{"1", "3", "5"}},
+		{rType: "A", name: "www", ids: []string{"1"}},
+		{rType: "SRV", ids: []string{}},
+	}
+
+	for _, c := range cases {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.domains.On("Records", "example.com", allPages).Return(records, nil)
+
+			var buf bytes.Buffer
+			config.Out = &buf
+			config.Args = append(config.Args, "example.com")
+			config.Doit.Set(config.NS, doctl.ArgRecordTypeFilter, c.rType)
+			config.Doit.Set(config.NS, doctl.ArgRecordNameFilter, c.name)
+			config.Doit.Set(config.NS, doctl.ArgFormat, "ID")
+			config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+			err := RunRecordList(config)
+			assert.NoError(t, err)
+			assert.Equal(t, c.ids, strings.Fields(buf.String()), "type %q name %q", c.rType, c.name)
+		})
+	}
+}
+
 func TestRecordList_RequiredArguments(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		err := RunRecordList(config)
@@ -148,6 +221,7 @@ func TestRecordsDelete(t *testing.T) {
 func TestRecordsUpdate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		dcer := &godo.DomainRecordEditRequest{Type: "A", Name: "foo.example.com.", Data: "192.168.1.1", Priority: 0, Port: 0, Weight: 0}
+		tm.domains.On("Record", "example.com", 1).Return(&testRecord, nil)
 		tm.domains.On("EditRecord", "example.com", 1, dcer).Return(&testRecord, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgRecordID, 1)
@@ -161,3 +235,62 @@ func TestRecordsUpdate(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestRecordsUpdatePartial(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		existing := do.DomainRecord{DomainRecord: &godo.DomainRecord{
+			ID: 1, Type: "MX", Name: "@", Data: "mail.example.com.", Priority: 10,
+		}}
+		dcer := &godo.DomainRecordEditRequest{Type: "MX", Name: "@", Data: "mail.example.com.", Priority: 20}
+		tm.domains.On("Record", "example.com", 1).Return(&existing, nil)
+		tm.domains.On("EditRecord", "example.com", 1, dcer).Return(&existing, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgRecordID, 1)
+		config.Doit.Set(config.NS, doctl.ArgRecordPriority, 20)
+
+		config.Args = append(config.Args, "example.com")
+
+		err := RunRecordUpdate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestRecordsUpdateRecordNotFound(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.domains.On("Record", "example.com", 1).Return(nil, errors.New("not found"))
+
+		config.Doit.Set(config.NS, doctl.ArgRecordID, 1)
+		config.Doit.Set(config.NS, doctl.ArgRecordData, "192.168.1.1")
+
+		config.Args = append(config.Args, "example.com")
+
+		err := RunRecordUpdate(config)
+		assert.Error(t, err)
+	})
+}
+
+func TestRecordsImport(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.domains.On("CreateRecord", "example.com", mock.AnythingOfType("*godo.DomainRecordEditRequest")).Return(&testRecord, nil).Times(8)
+
+		config.Doit.Set(config.NS, doctl.ArgZoneFile, "../testdata/example.com.zone")
+
+		config.Args = append(config.Args, "example.com")
+
+		err := RunRecordImport(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestRecordsImportDryRun(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgZoneFile, "../testdata/example.com.zone")
+		config.Doit.Set(config.NS, doctl.ArgDryRun, true)
+
+		config.Args = append(config.Args, "example.com")
+
+		err := RunRecordImport(config)
+		assert.NoError(t, err)
+		tm.domains.AssertNotCalled(t, "CreateRecord", mock.Anything, mock.Anything)
+	})
+}
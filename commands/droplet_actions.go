@@ -14,15 +14,26 @@ limitations under the License.
 package commands
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
 	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
 )
 
 type actionFn func(das do.DropletActionsService) (*do.Action, error)
 
+type tagActionFn func(das do.DropletActionsService, tag string) (do.Actions, error)
+
+type dropletActionFn func(das do.DropletActionsService, id int) (*do.Action, error)
+
 func performAction(c *CmdConfig, fn actionFn) error {
 	das := c.DropletActions()
 
@@ -37,7 +48,7 @@ func performAction(c *CmdConfig, fn actionFn) error {
 	}
 
 	if wait {
-		a, err = actionWait(c, a.ID, 5)
+		a, err = waitForActive(context.Background(), c.Actions(), a.ID, waitForever)
 		if err != nil {
 			return err
 		}
@@ -48,6 +59,119 @@ func performAction(c *CmdConfig, fn actionFn) error {
 	return c.Display(item)
 }
 
+// performTaggableAction performs an action on the droplet given as an
+// argument, or on every droplet with the tag given by --tag-name.
+func performTaggableAction(c *CmdConfig, fn actionFn, tagFn tagActionFn) error {
+	tag, err := c.Doit.GetString(c.NS, doctl.ArgTagName)
+	if err != nil {
+		return err
+	}
+
+	if tag == "" {
+		return performAction(c, fn)
+	}
+
+	if len(c.Args) > 0 {
+		return fmt.Errorf("droplet ids can't be combined with --%s", doctl.ArgTagName)
+	}
+
+	actions, err := tagFn(c.DropletActions(), tag)
+	if err != nil {
+		return err
+	}
+
+	return displayActions(c, actions)
+}
+
+// performDropletsAction performs an action on every droplet given as an
+// argument, or on every droplet with the tag given by --tag-name. If it fails
+// on some droplets, the actions started on the others are still displayed.
+func performDropletsAction(c *CmdConfig, fn dropletActionFn, tagFn tagActionFn) error {
+	actions, err := startDropletsAction(c, fn, tagFn)
+	if err != nil && len(actions) == 0 {
+		return err
+	}
+
+	if derr := displayActions(c, actions); derr != nil {
+		return derr
+	}
+
+	return err
+}
+
+// startDropletsAction starts an action on every droplet given as an argument,
+// or on every droplet with the tag given by --tag-name, without waiting for
+// any of them. A droplet the action fails on doesn't stop the others; the
+// actions that were started are returned along with an error naming the
+// droplets that failed.
+func startDropletsAction(c *CmdConfig, fn dropletActionFn, tagFn tagActionFn) (do.Actions, error) {
+	tag, err := c.Doit.GetString(c.NS, doctl.ArgTagName)
+	if err != nil {
+		return nil, err
+	}
+
+	das := c.DropletActions()
+
+	if tag != "" {
+		if len(c.Args) > 0 {
+			return nil, fmt.Errorf("droplet ids can't be combined with --%s", doctl.ArgTagName)
+		}
+
+		return tagFn(das, tag)
+	}
+
+	if len(c.Args) == 0 {
+		return nil, doctl.NewMissingArgsErr(c.NS)
+	}
+
+	ids, err := allInt(c.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions do.Actions
+	var failed []string
+	for _, id := range ids {
+		a, err := fn(das, id)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("droplet %d: %v", id, err))
+			continue
+		}
+		actions = append(actions, *a)
+	}
+
+	switch {
+	case len(failed) == 0:
+		return actions, nil
+	case len(ids) == 1:
+		return actions, errors.New(failed[0])
+	default:
+		return actions, fmt.Errorf("failed on %d of %d droplet(s):\n %s", len(failed), len(ids), strings.Join(failed, "\n "))
+	}
+}
+
+// displayActions displays actions, first waiting for them to complete if
+// --wait was given.
+func displayActions(c *CmdConfig, actions do.Actions) error {
+	wait, err := c.Doit.GetBool(c.NS, doctl.ArgCommandWait)
+	if err != nil {
+		return err
+	}
+
+	if wait {
+		for i := range actions {
+			a, err := waitForActive(context.Background(), c.Actions(), actions[i].ID, waitForever)
+			if err != nil {
+				return err
+			}
+			actions[i] = *a
+		}
+	}
+
+	item := &action{actions: actions}
+	return c.Display(item)
+}
+
 // DropletAction creates the droplet-action command.
 func DropletAction() *Command {
 	cmd := &Command{
@@ -63,92 +187,114 @@ func DropletAction() *Command {
 		aliasOpt("g"), displayerType(&action{}), docCategories("droplet"))
 	AddIntFlag(cmdDropletActionGet, doctl.ArgActionID, 0, "Action ID", requiredOpt())
 
+	cmdDropletActionEnableBackups := CmdBuilder(cmd, RunDropletActionEnableBackups,
+		"enable-backups <droplet-id> [<droplet-id> ...]", "enable backups", Writer,
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
+	AddBoolFlag(cmdDropletActionEnableBackups, doctl.ArgCommandWait, false, "Wait for action to complete")
+	AddStringFlag(cmdDropletActionEnableBackups, doctl.ArgTagName, "", "Tag name")
+
 	cmdDropletActionDisableBackups := CmdBuilder(cmd, RunDropletActionDisableBackups,
-		"disable-backups <droplet-id>", "disable backups", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		"disable-backups <droplet-id> [<droplet-id> ...]", "disable backups", Writer,
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddBoolFlag(cmdDropletActionDisableBackups, doctl.ArgCommandWait, false, "Wait for action to complete")
+	AddStringFlag(cmdDropletActionDisableBackups, doctl.ArgTagName, "", "Tag name")
 
 	cmdDropletActionReboot := CmdBuilder(cmd, RunDropletActionReboot,
 		"reboot <droplet-id>", "reboot droplet", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddBoolFlag(cmdDropletActionReboot, doctl.ArgCommandWait, false, "Wait for action to complete")
 
 	cmdDropletActionPowerCycle := CmdBuilder(cmd, RunDropletActionPowerCycle,
-		"power-cycle <droplet-id>", "power cycle droplet", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		"power-cycle [<droplet-id>]", "power cycle droplet", Writer,
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddBoolFlag(cmdDropletActionPowerCycle, doctl.ArgCommandWait, false, "Wait for action to complete")
+	AddStringFlag(cmdDropletActionPowerCycle, doctl.ArgTagName, "", "Tag name")
 
 	cmdDropletActionShutdown := CmdBuilder(cmd, RunDropletActionShutdown,
-		"shutdown <droplet-id>", "shutdown droplet", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		"shutdown <droplet-id> [<droplet-id> ...]", "gracefully shut down droplets", Writer,
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
+	cmdDropletActionShutdown.Long = `shutdown asks the droplet's operating system to shut down cleanly, like
+pressing the power button. The droplet may take a while to stop, or not stop
+at all if the OS ignores the request. Use --force-after to power off droplets
+that haven't shut down within the given duration, e.g. --force-after 2m.`
 	AddBoolFlag(cmdDropletActionShutdown, doctl.ArgCommandWait, false, "Wait for action to complete")
+	AddStringFlag(cmdDropletActionShutdown, doctl.ArgTagName, "", "Tag name")
+	AddStringFlag(cmdDropletActionShutdown, doctl.ArgForceAfter, "", "Power off droplets that haven't shut down after this duration")
 
 	cmdDropletActionPowerOff := CmdBuilder(cmd, RunDropletActionPowerOff,
-		"power-off <droplet-id>", "power off droplet", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		"power-off <droplet-id> [<droplet-id> ...]", "power off droplets", Writer,
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
+	cmdDropletActionPowerOff.Long = `power-off cuts power to the droplet immediately, like pulling the plug.
+Unsaved data may be lost; use shutdown for a graceful stop.`
 	AddBoolFlag(cmdDropletActionPowerOff, doctl.ArgCommandWait, false, "Wait for action to complete")
+	AddStringFlag(cmdDropletActionPowerOff, doctl.ArgTagName, "", "Tag name")
 
 	cmdDropletActionPowerOn := CmdBuilder(cmd, RunDropletActionPowerOn,
-		"power-on <droplet-id>", "power on droplet", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		"power-on [<droplet-id>]", "power on droplet", Writer,
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddBoolFlag(cmdDropletActionPowerOn, doctl.ArgCommandWait, false, "Wait for action to complete")
+	AddStringFlag(cmdDropletActionPowerOn, doctl.ArgTagName, "", "Tag name")
 
 	cmdDropletActionPasswordReset := CmdBuilder(cmd, RunDropletActionPasswordReset,
 		"password-reset <droplet-id>", "password reset droplet", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddBoolFlag(cmdDropletActionPasswordReset, doctl.ArgCommandWait, false, "Wait for action to complete")
 
 	cmdDropletActionEnableIPv6 := CmdBuilder(cmd, RunDropletActionEnableIPv6,
-		"enable-ipv6 <droplet-id>", "enable ipv6", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		"enable-ipv6 [<droplet-id>]", "enable ipv6", Writer,
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddBoolFlag(cmdDropletActionEnableIPv6, doctl.ArgCommandWait, false, "Wait for action to complete")
+	AddStringFlag(cmdDropletActionEnableIPv6, doctl.ArgTagName, "", "Tag name")
 
 	cmdDropletActionEnablePrivateNetworking := CmdBuilder(cmd, RunDropletActionEnablePrivateNetworking,
-		"enable-private-networking <droplet-id>", "enable private networking", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		"enable-private-networking [<droplet-id>]", "enable private networking", Writer,
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddBoolFlag(cmdDropletActionEnablePrivateNetworking, doctl.ArgCommandWait, false, "Wait for action to complete")
+	AddStringFlag(cmdDropletActionEnablePrivateNetworking, doctl.ArgTagName, "", "Tag name")
 
 	cmdDropletActionUpgrade := CmdBuilder(cmd, RunDropletActionUpgrade,
 		"upgrade <droplet-id>", "upgrade droplet", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddBoolFlag(cmdDropletActionUpgrade, doctl.ArgCommandWait, false, "Wait for action to complete")
 
 	cmdDropletActionRestore := CmdBuilder(cmd, RunDropletActionRestore,
 		"restore <droplet-id>", "restore backup", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddIntFlag(cmdDropletActionRestore, doctl.ArgImageID, 0, "Image ID", requiredOpt())
 	AddBoolFlag(cmdDropletActionRestore, doctl.ArgCommandWait, false, "Wait for action to complete")
 
 	cmdDropletActionResize := CmdBuilder(cmd, RunDropletActionResize,
 		"resize <droplet-id>", "resize droplet", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddBoolFlag(cmdDropletActionResize, doctl.ArgResizeDisk, false, "Resize disk")
 	AddStringFlag(cmdDropletActionResize, doctl.ArgSizeSlug, "", "New size")
 	AddBoolFlag(cmdDropletActionResize, doctl.ArgCommandWait, false, "Wait for action to complete")
 
 	cmdDropletActionRebuild := CmdBuilder(cmd, RunDropletActionRebuild,
 		"rebuild <droplet-id>", "rebuild droplet", Writer,
-		displayerType(&action{}), docCategories("droplet"))
-	AddStringFlag(cmdDropletActionRebuild, doctl.ArgImage, "", "Image ID or Slug", requiredOpt())
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
+	AddStringFlag(cmdDropletActionRebuild, doctl.ArgImage, "", "Image ID, slug or snapshot name", requiredOpt())
 	AddBoolFlag(cmdDropletActionRebuild, doctl.ArgCommandWait, false, "Wait for action to complete")
+	AddBoolFlag(cmdDropletActionRebuild, doctl.ArgDeleteForce, false, "Rebuild without asking for confirmation")
 
 	cmdDropletActionRename := CmdBuilder(cmd, RunDropletActionRename,
 		"rename <droplet-id>", "rename droplet", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddStringFlag(cmdDropletActionRename, doctl.ArgDropletName, "", "Droplet name", requiredOpt())
 	AddBoolFlag(cmdDropletActionRename, doctl.ArgCommandWait, false, "Wait for action to complete")
 
 	cmdDropletActionChangeKernel := CmdBuilder(cmd, RunDropletActionChangeKernel,
 		"change-kernel <droplet-id>", "change kernel", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddIntFlag(cmdDropletActionChangeKernel, doctl.ArgKernelID, 0, "Kernel ID", requiredOpt())
 	AddBoolFlag(cmdDropletActionChangeKernel, doctl.ArgCommandWait, false, "Wait for action to complete")
 
 	cmdDropletActionSnapshot := CmdBuilder(cmd, RunDropletActionSnapshot,
-		"snapshot <droplet-id>", "snapshot droplet", Writer,
-		displayerType(&action{}), docCategories("droplet"))
+		"snapshot [<droplet-id>]", "snapshot droplet", Writer,
+		displayerType(&action{}), docCategories("droplet"), completeArgs("droplet"))
 	AddStringFlag(cmdDropletActionSnapshot, doctl.ArgSnapshotName, "", "Snapshot name", requiredOpt())
 	AddBoolFlag(cmdDropletActionSnapshot, doctl.ArgCommandWait, false, "Wait for action to complete")
+	AddStringFlag(cmdDropletActionSnapshot, doctl.ArgTagName, "", "Tag name")
 
 	return cmd
 }
@@ -176,22 +322,30 @@ func RunDropletActionGet(c *CmdConfig) error {
 	return performAction(c, fn)
 }
 
-// RunDropletActionDisableBackups disables backups for a droplet.
+// RunDropletActionEnableBackups enables backups for droplets.
+func RunDropletActionEnableBackups(c *CmdConfig) error {
+	fn := func(das do.DropletActionsService, id int) (*do.Action, error) {
+		return das.EnableBackups(id)
+	}
+
+	tagFn := func(das do.DropletActionsService, tag string) (do.Actions, error) {
+		return das.EnableBackupsByTag(tag)
+	}
+
+	return performDropletsAction(c, fn, tagFn)
+}
+
+// RunDropletActionDisableBackups disables backups for droplets.
 func RunDropletActionDisableBackups(c *CmdConfig) error {
-	fn := func(das do.DropletActionsService) (*do.Action, error) {
-		if len(c.Args) != 1 {
-			return nil, doctl.NewMissingArgsErr(c.NS)
-		}
-		id, err := strconv.Atoi(c.Args[0])
-		if err != nil {
-			return nil, err
-		}
+	fn := func(das do.DropletActionsService, id int) (*do.Action, error) {
+		return das.DisableBackups(id)
+	}
 
-		a, err := das.DisableBackups(id)
-		return a, err
+	tagFn := func(das do.DropletActionsService, tag string) (do.Actions, error) {
+		return das.DisableBackupsByTag(tag)
 	}
 
-	return performAction(c, fn)
+	return performDropletsAction(c, fn, tagFn)
 }
 
 // RunDropletActionReboot reboots a droplet.
@@ -228,41 +382,117 @@ func RunDropletActionPowerCycle(c *CmdConfig) error {
 		return a, err
 	}
 
-	return performAction(c, fn)
+	tagFn := func(das do.DropletActionsService, tag string) (do.Actions, error) {
+		return das.PowerCycleByTag(tag)
+	}
+
+	return performTaggableAction(c, fn, tagFn)
 }
 
-// RunDropletActionShutdown shuts a droplet down.
+// RunDropletActionShutdown gracefully shuts down droplets, powering off the
+// ones that are still running after --force-after.
 func RunDropletActionShutdown(c *CmdConfig) error {
-	fn := func(das do.DropletActionsService) (*do.Action, error) {
-		if len(c.Args) != 1 {
-			return nil, doctl.NewMissingArgsErr(c.NS)
-		}
-		id, err := strconv.Atoi(c.Args[0])
+	forceAfter, err := c.Doit.GetString(c.NS, doctl.ArgForceAfter)
+	if err != nil {
+		return err
+	}
 
-		a, err := das.Shutdown(id)
-		return a, err
+	fn := func(das do.DropletActionsService, id int) (*do.Action, error) {
+		return das.Shutdown(id)
 	}
 
-	return performAction(c, fn)
+	tagFn := func(das do.DropletActionsService, tag string) (do.Actions, error) {
+		return das.ShutdownByTag(tag)
+	}
+
+	if forceAfter == "" {
+		return performDropletsAction(c, fn, tagFn)
+	}
+
+	timeout, err := time.ParseDuration(forceAfter)
+	if err != nil || timeout <= 0 {
+		return fmt.Errorf("invalid --%s %q, expected a duration like 90s or 2m", doctl.ArgForceAfter, forceAfter)
+	}
+
+	actions, err := startDropletsAction(c, fn, tagFn)
+	if err != nil && len(actions) == 0 {
+		return err
+	}
+
+	if perr := powerOffAfter(c, actions, timeout); perr != nil {
+		return perr
+	}
+
+	if derr := displayActions(c, actions); derr != nil {
+		return derr
+	}
+
+	return err
 }
 
-// RunDropletActionPowerOff turns droplet power off.
-func RunDropletActionPowerOff(c *CmdConfig) error {
-	fn := func(das do.DropletActionsService) (*do.Action, error) {
-		if len(c.Args) != 1 {
-			return nil, doctl.NewMissingArgsErr(c.NS)
+// powerOffAfter waits up to timeout for every shutdown action to complete.
+// Droplets whose shutdown is still in progress or has errored by then are
+// powered off, and their power off action replaces the shutdown in actions.
+func powerOffAfter(c *CmdConfig, actions do.Actions, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	done := make([]bool, len(actions))
+	errs := make([]error, len(actions))
+
+	var wg sync.WaitGroup
+	for i := range actions {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			a, err := waitForActive(ctx, c.Actions(), actions[i].ID, timeout)
+			if _, timedOut := err.(*waitTimeoutError); timedOut || err == context.DeadlineExceeded {
+				return
+			}
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			actions[i] = *a
+			done[i] = a.Status == godo.ActionCompleted
+		}(i)
+	}
+	wg.Wait()
+
+	das := c.DropletActions()
+	for i := range actions {
+		dropletID := actions[i].ResourceID
+		if errs[i] != nil {
+			return fmt.Errorf("droplet %d: %v", dropletID, errs[i])
+		}
+		if done[i] {
+			continue
 		}
-		id, err := strconv.Atoi(c.Args[0])
 
+		warn(fmt.Sprintf("droplet %d did not shut down within %s, powering off", dropletID, timeout))
+		a, err := das.PowerOff(dropletID)
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("droplet %d: %v", dropletID, err)
 		}
+		actions[i] = *a
+	}
 
-		a, err := das.PowerOff(id)
-		return a, err
+	return nil
+}
+
+// RunDropletActionPowerOff powers off droplets.
+func RunDropletActionPowerOff(c *CmdConfig) error {
+	fn := func(das do.DropletActionsService, id int) (*do.Action, error) {
+		return das.PowerOff(id)
 	}
 
-	return performAction(c, fn)
+	tagFn := func(das do.DropletActionsService, tag string) (do.Actions, error) {
+		return das.PowerOffByTag(tag)
+	}
+
+	return performDropletsAction(c, fn, tagFn)
 }
 
 // RunDropletActionPowerOn turns droplet power on.
@@ -281,7 +511,11 @@ func RunDropletActionPowerOn(c *CmdConfig) error {
 		return a, err
 	}
 
-	return performAction(c, fn)
+	tagFn := func(das do.DropletActionsService, tag string) (do.Actions, error) {
+		return das.PowerOnByTag(tag)
+	}
+
+	return performTaggableAction(c, fn, tagFn)
 }
 
 // RunDropletActionPasswordReset resets the droplet root password.
@@ -319,7 +553,11 @@ func RunDropletActionEnableIPv6(c *CmdConfig) error {
 		return a, err
 	}
 
-	return performAction(c, fn)
+	tagFn := func(das do.DropletActionsService, tag string) (do.Actions, error) {
+		return das.EnableIPv6ByTag(tag)
+	}
+
+	return performTaggableAction(c, fn, tagFn)
 }
 
 // RunDropletActionEnablePrivateNetworking enables private networking for a droplet.
@@ -338,7 +576,11 @@ func RunDropletActionEnablePrivateNetworking(c *CmdConfig) error {
 		return a, err
 	}
 
-	return performAction(c, fn)
+	tagFn := func(das do.DropletActionsService, tag string) (do.Actions, error) {
+		return das.EnablePrivateNetworkingByTag(tag)
+	}
+
+	return performTaggableAction(c, fn, tagFn)
 }
 
 // RunDropletActionUpgrade upgrades a droplet.
@@ -414,14 +656,24 @@ func RunDropletActionResize(c *CmdConfig) error {
 	return performAction(c, fn)
 }
 
-// RunDropletActionRebuild rebuilds a droplet using an image id or slug.
+// RunDropletActionRebuild rebuilds a droplet using an image id, slug or the
+// name of a snapshot or backup.
 func RunDropletActionRebuild(c *CmdConfig) error {
+	if len(c.Args) != 1 {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+
+	force, err := c.Doit.GetBool(c.NS, doctl.ArgDeleteForce)
+	if err != nil {
+		return err
+	}
+
+	if !force && AskForConfirm("rebuild droplet "+c.Args[0]+", erasing its disk") != nil {
+		return fmt.Errorf("Operation aborted.")
+	}
+
 	fn := func(das do.DropletActionsService) (*do.Action, error) {
-		if len(c.Args) != 1 {
-			return nil, doctl.NewMissingArgsErr(c.NS)
-		}
 		id, err := strconv.Atoi(c.Args[0])
-
 		if err != nil {
 			return nil, err
 		}
@@ -431,13 +683,35 @@ func RunDropletActionRebuild(c *CmdConfig) error {
 			return nil, err
 		}
 
-		var a *do.Action
 		if i, aerr := strconv.Atoi(image); aerr == nil {
-			a, err = das.RebuildByImageID(id, i)
-		} else {
-			a, err = das.RebuildByImageSlug(id, image)
+			return das.RebuildByImageID(id, i)
 		}
-		return a, err
+
+		r := newImageResolver(c.Images())
+		if r.isSlug(image) {
+			return das.RebuildByImageSlug(id, image)
+		}
+
+		d, err := c.Droplets().Get(id)
+		if err != nil {
+			return nil, err
+		}
+
+		var region string
+		if d.Region != nil {
+			region = d.Region.Slug
+		}
+
+		imageID, err := r.byName(image, region)
+		if err != nil {
+			return nil, err
+		}
+
+		// leave it to the API to report an unknown slug.
+		if imageID == 0 {
+			return das.RebuildByImageSlug(id, image)
+		}
+		return das.RebuildByImageID(id, imageID)
 	}
 
 	return performAction(c, fn)
@@ -512,5 +786,14 @@ func RunDropletActionSnapshot(c *CmdConfig) error {
 		return a, err
 	}
 
-	return performAction(c, fn)
+	tagFn := func(das do.DropletActionsService, tag string) (do.Actions, error) {
+		name, err := c.Doit.GetString(c.NS, doctl.ArgSnapshotName)
+		if err != nil {
+			return nil, err
+		}
+
+		return das.SnapshotByTag(tag, name)
+	}
+
+	return performTaggableAction(c, fn, tagFn)
 }
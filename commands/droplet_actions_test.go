@@ -14,16 +14,22 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestDropletActionCommand(t *testing.T) {
 	cmd := DropletAction()
 	assert.NotNil(t, cmd)
-	assertCommandNames(t, cmd, "change-kernel", "disable-backups", "enable-ipv6", "enable-private-networking", "get", "power-cycle", "power-off", "power-on", "password-reset", "reboot", "rebuild", "rename", "resize", "restore", "shutdown", "snapshot", "upgrade")
+	assertCommandNames(t, cmd, "change-kernel", "disable-backups", "enable-backups", "enable-ipv6", "enable-private-networking", "get", "power-cycle", "power-off", "power-on", "password-reset", "reboot", "rebuild", "rename", "resize", "restore", "shutdown", "snapshot", "upgrade")
 }
 
 func TestDropletActionsChangeKernel(t *testing.T) {
@@ -48,6 +54,47 @@ func TestDropletActionsDisableBackups(t *testing.T) {
 	})
 
 }
+func TestDropletActionsDisableBackupsMultiple(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.dropletActions.On("DisableBackups", 1).Return(&testAction, nil)
+		tm.dropletActions.On("DisableBackups", 2).Return(&testAction, nil)
+
+		config.Args = append(config.Args, "1", "2")
+
+		err := RunDropletActionDisableBackups(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletActionsEnableBackups(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.dropletActions.On("EnableBackups", 1).Return(&testAction, nil)
+
+		config.Args = append(config.Args, "1")
+
+		err := RunDropletActionEnableBackups(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletActionsEnableBackupsByTag(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.dropletActions.On("EnableBackupsByTag", "web").Return(testActionList, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgTagName, "web")
+
+		err := RunDropletActionEnableBackups(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletActionsEnableBackupsMissingArgs(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		err := RunDropletActionEnableBackups(config)
+		assert.Error(t, err)
+	})
+}
+
 func TestDropletActionsEnableIPv6(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.dropletActions.On("EnableIPv6", 1).Return(&testAction, nil)
@@ -114,6 +161,59 @@ func TestDropletActionsPowerOff(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestDropletActionsPowerOffMultiple(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.dropletActions.On("PowerOff", 1).Return(&testAction, nil)
+		tm.dropletActions.On("PowerOff", 3).Return(&testAction, nil)
+
+		config.Args = append(config.Args, "1", "3")
+
+		err := RunDropletActionPowerOff(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletActionsPowerOffPartialFailure(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		first := do.Action{Action: &godo.Action{ID: 11, Status: godo.ActionInProgress}}
+		last := do.Action{Action: &godo.Action{ID: 13, Status: godo.ActionInProgress}}
+		tm.dropletActions.On("PowerOff", 1).Return(&first, nil)
+		tm.dropletActions.On("PowerOff", 2).Return(nil, errors.New("not found"))
+		tm.dropletActions.On("PowerOff", 3).Return(&last, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "1", "2", "3")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletActionPowerOff(config)
+		assert.EqualError(t, err, "failed on 1 of 3 droplet(s):\n droplet 2: not found")
+		assert.Equal(t, "11\n13\n", buf.String())
+	})
+}
+
+func TestDropletActionsPowerOffByTag(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.dropletActions.On("PowerOffByTag", "web").Return(testActionList, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgTagName, "web")
+
+		err := RunDropletActionPowerOff(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletActionsPowerOffByTag_WithIDs(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgTagName, "web")
+
+		err := RunDropletActionPowerOff(config)
+		assert.Error(t, err)
+	})
+}
 func TestDropletActionsPowerOn(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.dropletActions.On("PowerOn", 1).Return(&testAction, nil)
@@ -143,6 +243,7 @@ func TestDropletActionsRebuildByImageID(t *testing.T) {
 		config.Args = append(config.Args, "1")
 
 		config.Doit.Set(config.NS, doctl.ArgImage, "2")
+		config.Doit.Set(config.NS, doctl.ArgDeleteForce, true)
 
 		err := RunDropletActionRebuild(config)
 		assert.NoError(t, err)
@@ -153,11 +254,13 @@ func TestDropletActionsRebuildByImageID(t *testing.T) {
 
 func TestDropletActionsRebuildByImageSlug(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.images.On("GetBySlug", "slug").Return(&testImage, nil)
 		tm.dropletActions.On("RebuildByImageSlug", 1, "slug").Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
 		config.Doit.Set(config.NS, doctl.ArgImage, "slug")
+		config.Doit.Set(config.NS, doctl.ArgDeleteForce, true)
 
 		err := RunDropletActionRebuild(config)
 		assert.NoError(t, err)
@@ -166,6 +269,47 @@ func TestDropletActionsRebuildByImageSlug(t *testing.T) {
 	})
 
 }
+
+func TestDropletActionsRebuildBySnapshotName(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		snapshots := do.Images{
+			{Image: &godo.Image{ID: 7, Name: "golden", Regions: []string{"nyc3"}}},
+			{Image: &godo.Image{ID: 8, Name: "golden", Regions: []string{"test0"}}},
+		}
+		tm.images.On("GetBySlug", "golden").Return(nil, errors.New("not found"))
+		tm.images.On("ListUser", false, allPages).Return(snapshots, nil)
+		tm.droplets.On("Get", 1).Return(&testDroplet, nil)
+		tm.dropletActions.On("RebuildByImageID", 1, 8).Return(&testAction, nil)
+
+		config.Args = append(config.Args, "1")
+
+		config.Doit.Set(config.NS, doctl.ArgImage, "golden")
+		config.Doit.Set(config.NS, doctl.ArgDeleteForce, true)
+
+		err := RunDropletActionRebuild(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletActionsRebuildAborted(t *testing.T) {
+	rui := retrieveUserInput
+	defer func() {
+		retrieveUserInput = rui
+	}()
+
+	retrieveUserInput = func(string) (string, error) {
+		return "no", nil
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgImage, "2")
+
+		err := RunDropletActionRebuild(config)
+		assert.EqualError(t, err, "Operation aborted.")
+	})
+}
+
 func TestDropletActionsRename(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.dropletActions.On("Rename", 1, "name").Return(&testAction, nil)
@@ -217,6 +361,74 @@ func TestDropletActionsShutdown(t *testing.T) {
 	})
 }
 
+func TestDropletActionsShutdownMultiple(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.dropletActions.On("Shutdown", 1).Return(&testAction, nil)
+		tm.dropletActions.On("Shutdown", 3).Return(&testAction, nil)
+
+		config.Args = append(config.Args, "1", "3")
+
+		err := RunDropletActionShutdown(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletActionsShutdownForceAfter(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		completed := do.Action{Action: &godo.Action{ID: 1, ResourceID: 1, Status: godo.ActionCompleted}}
+		inProgress := do.Action{Action: &godo.Action{ID: 2, ResourceID: 3, Status: godo.ActionInProgress}}
+		stuck := do.Action{Action: &godo.Action{ID: 3, ResourceID: 5, Status: godo.ActionInProgress}}
+		powerOff := do.Action{Action: &godo.Action{ID: 4, Status: godo.ActionInProgress}}
+
+		var calls []string
+		record := func(args mock.Arguments) {
+			calls = append(calls, fmt.Sprintf("%v", args.Get(0)))
+		}
+
+		tm.dropletActions.On("Shutdown", 1).Return(&completed, nil).Run(record)
+		tm.dropletActions.On("Shutdown", 3).Return(&inProgress, nil).Run(record)
+		tm.dropletActions.On("Shutdown", 5).Return(&stuck, nil).Run(record)
+		tm.actions.On("Get", 1).Return(&completed, nil)
+		tm.actions.On("Get", 2).Return(&inProgress, nil)
+		tm.actions.On("Get", 3).Return(&stuck, nil)
+		tm.dropletActions.On("PowerOff", 3).Return(&powerOff, nil).Run(record)
+		tm.dropletActions.On("PowerOff", 5).Return(&powerOff, nil).Run(record)
+
+		config.Args = append(config.Args, "1", "3", "5")
+		config.Doit.Set(config.NS, doctl.ArgForceAfter, "1ms")
+
+		err := RunDropletActionShutdown(config)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "3", "5", "3", "5"}, calls)
+	})
+}
+
+func TestDropletActionsShutdownForceAfterByTag(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		errored := do.Action{Action: &godo.Action{ID: 2, ResourceID: 3, Status: "errored"}}
+
+		tm.dropletActions.On("ShutdownByTag", "web").Return(do.Actions{errored}, nil)
+		tm.actions.On("Get", 2).Return(&errored, nil)
+		tm.dropletActions.On("PowerOff", 3).Return(&testAction, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgTagName, "web")
+		config.Doit.Set(config.NS, doctl.ArgForceAfter, "1m")
+
+		err := RunDropletActionShutdown(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletActionsShutdownInvalidForceAfter(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgForceAfter, "soon")
+
+		err := RunDropletActionShutdown(config)
+		assert.EqualError(t, err, `invalid --force-after "soon", expected a duration like 90s or 2m`)
+	})
+}
+
 func TestDropletActionsSnapshot(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.dropletActions.On("Snapshot", 1, "name").Return(&testAction, nil)
@@ -230,6 +442,18 @@ func TestDropletActionsSnapshot(t *testing.T) {
 	})
 }
 
+func TestDropletActionsSnapshotByTag(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.dropletActions.On("SnapshotByTag", "web", "name").Return(testActionList, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgTagName, "web")
+		config.Doit.Set(config.NS, doctl.ArgSnapshotName, "name")
+
+		err := RunDropletActionSnapshot(config)
+		assert.NoError(t, err)
+	})
+}
+
 func TestDropletActionsUpgrade(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.dropletActions.On("Upgrade", 1).Return(&testAction, nil)
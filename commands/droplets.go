@@ -14,13 +14,17 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"fmt"
-	"html/template"
+	"io"
 	"io/ioutil"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"text/template"
+	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
@@ -28,6 +32,8 @@ import (
 	"github.com/gobwas/glob"
 	"github.com/pborman/uuid"
 	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
+	"gopkg.in/yaml.v2"
 )
 
 // Droplet creates the droplet command.
@@ -43,38 +49,49 @@ func Droplet() *Command {
 		IsIndex:       true,
 	}
 
-	CmdBuilder(cmd, RunDropletActions, "actions <droplet id>", "droplet actions", Writer,
+	cmdDropletActions := CmdBuilder(cmd, RunDropletActions, "actions <droplet id>", "droplet actions", Writer,
 		aliasOpt("a"), displayerType(&action{}), docCategories("droplet"))
+	AddStringFlag(cmdDropletActions, doctl.ArgActionType, "", "Only list actions of this type, e.g. power_on or reboot")
+	AddStringFlag(cmdDropletActions, doctl.ArgActionStatus, "", "Only list actions with this status: in-progress, completed or errored")
 
 	CmdBuilder(cmd, RunDropletBackups, "backups <droplet id>", "droplet backups", Writer,
 		aliasOpt("b"), displayerType(&image{}), docCategories("droplet"))
 
 	cmdDropletCreate := CmdBuilder(cmd, RunDropletCreate, "create NAME [NAME ...]", "create droplet", Writer,
 		aliasOpt("c"), displayerType(&droplet{}), docCategories("droplet"))
-	AddStringSliceFlag(cmdDropletCreate, doctl.ArgSSHKeys, []string{}, "SSH Keys or fingerprints")
+	AddStringSliceFlag(cmdDropletCreate, doctl.ArgSSHKeys, []string{}, "SSH key IDs, fingerprints or names")
 	AddStringFlag(cmdDropletCreate, doctl.ArgUserData, "", "User data")
 	AddStringFlag(cmdDropletCreate, doctl.ArgUserDataFile, "", "User data file")
+	AddStringSliceFlag(cmdDropletCreate, doctl.ArgUserDataVars, []string{}, "Variables to render the user data template with, as key=value")
 	AddBoolFlag(cmdDropletCreate, doctl.ArgCommandWait, false, "Wait for droplet to be created")
-	AddStringFlag(cmdDropletCreate, doctl.ArgRegionSlug, "", "Droplet region",
+	AddIntFlag(cmdDropletCreate, doctl.ArgWaitTimeout, 300, waitTimeoutUsage)
+	AddStringFlag(cmdDropletCreate, doctl.ArgRegionSlug, "", "Droplet region, or auto for the lowest latency region offering the size",
 		requiredOpt())
 	AddStringFlag(cmdDropletCreate, doctl.ArgSizeSlug, "", "Droplet size",
 		requiredOpt())
 	AddBoolFlag(cmdDropletCreate, doctl.ArgBackups, false, "Backup droplet")
 	AddBoolFlag(cmdDropletCreate, doctl.ArgIPv6, false, "IPv6 support")
 	AddBoolFlag(cmdDropletCreate, doctl.ArgPrivateNetworking, false, "Private networking")
-	AddStringFlag(cmdDropletCreate, doctl.ArgImage, "", "Droplet image",
+	AddStringFlag(cmdDropletCreate, doctl.ArgImage, "", "Droplet image slug, ID or snapshot name",
 		requiredOpt())
 	AddStringFlag(cmdDropletCreate, doctl.ArgTagName, "", "Tag name")
+	AddStringSliceFlag(cmdDropletCreate, doctl.ArgTagNames, []string{}, "Tag names, created if they don't exist")
+	AddBoolFlag(cmdDropletCreate, doctl.ArgNoCreateTags, false, "Fail instead of creating tags that don't exist")
 
-	AddStringSliceFlag(cmdDropletCreate, doctl.ArgVolumeList, []string{}, "Volumes to attach")
+	AddStringSliceFlag(cmdDropletCreate, doctl.ArgVolumeList, []string{}, "Volume IDs or names to attach")
+	AddIntFlag(cmdDropletCreate, doctl.ArgDropletCount, 0, "Number of droplets to create from a single NAME, named NAME-1, NAME-2, ...")
+	AddStringFlag(cmdDropletCreate, doctl.ArgNameTemplate, "", "Template for the names of droplets created with --count, e.g. 'web-{{.Index}}-{{.Region}}' (fields: Name, Index, Region)")
+	AddStringFlag(cmdDropletCreate, doctl.ArgDropletFromFile, "", "YAML or JSON file with a list of droplets to create")
+	AddBoolFlag(cmdDropletCreate, doctl.ArgDryRun, false, "Print the droplets from --from-file without creating them")
+	AddBoolFlag(cmdDropletCreate, doctl.ArgNoPreflight, false, "Skip checking the size is available in the region before creating")
 
 	cmdRunDropletDelete := CmdBuilder(cmd, RunDropletDelete, "delete ID [ID|Name ...]", "Delete droplet by id or name", Writer,
-		aliasOpt("d", "del", "rm"), docCategories("droplet"))
+		aliasOpt("d", "del", "rm"), docCategories("droplet"), completeArgs("droplet"))
 	AddBoolFlag(cmdRunDropletDelete, doctl.ArgDeleteForce, false, "Force droplet delete")
+	AddIntFlag(cmdRunDropletDelete, doctl.ArgMaxConcurrency, 10, "Maximum number of droplets to delete at once")
 
-	cmdRunDropletGet := CmdBuilder(cmd, RunDropletGet, "get", "get droplet", Writer,
-		aliasOpt("g"), displayerType(&droplet{}), docCategories("droplet"))
-	AddStringFlag(cmdRunDropletGet, doctl.ArgTemplate, "", "Template format")
+	CmdBuilder(cmd, RunDropletGet, "get", "get droplet", Writer,
+		aliasOpt("g"), displayerType(&droplet{}), docCategories("droplet"), completeArgs("droplet"))
 
 	CmdBuilder(cmd, RunDropletKernels, "kernels <droplet id>", "droplet kernels", Writer,
 		aliasOpt("k"), displayerType(&kernel{}), docCategories("droplet"))
@@ -83,20 +100,22 @@ func Droplet() *Command {
 		aliasOpt("ls"), displayerType(&droplet{}), docCategories("droplet"))
 	AddStringFlag(cmdRunDropletList, doctl.ArgRegionSlug, "", "Droplet region")
 	AddStringFlag(cmdRunDropletList, doctl.ArgTagName, "", "Tag name")
+	AddStringFlag(cmdRunDropletList, doctl.ArgImage, "", "Droplet image slug or ID")
 
-	CmdBuilder(cmd, RunDropletNeighbors, "neighbors <droplet id>", "droplet neighbors", Writer,
+	cmdDropletNeighbors := CmdBuilder(cmd, RunDropletNeighbors, "neighbors <droplet id>", "droplet neighbors", Writer,
 		aliasOpt("n"), displayerType(&droplet{}), docCategories("droplet"))
+	AddBoolFlag(cmdDropletNeighbors, doctl.ArgNeighborsAll, false, "List every group of droplets that share hardware")
 
 	CmdBuilder(cmd, RunDropletSnapshots, "snapshots <droplet id>", "snapshots", Writer,
 		aliasOpt("s"), displayerType(&image{}), docCategories("droplet"))
 
 	cmdRunDropletTag := CmdBuilder(cmd, RunDropletTag, "tag <droplet id or name>", "tag", Writer,
-		docCategories("droplet"))
+		docCategories("droplet"), completeArgs("droplet"))
 	AddStringFlag(cmdRunDropletTag, doctl.ArgTagName, "", "Tag name",
 		requiredOpt())
 
 	cmdRunDropletUntag := CmdBuilder(cmd, RunDropletUntag, "untag <droplet id or name>", "untag", Writer,
-		docCategories("droplet"))
+		docCategories("droplet"), completeArgs("droplet"))
 	AddStringSliceFlag(cmdRunDropletUntag, doctl.ArgTagName, []string{}, "tag names")
 
 	return cmd
@@ -112,10 +131,40 @@ func RunDropletActions(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := ds.Actions(id)
+	actionType, err := c.Doit.GetString(c.NS, doctl.ArgActionType)
 	if err != nil {
 		return err
 	}
+
+	status, err := c.Doit.GetString(c.NS, doctl.ArgActionStatus)
+	if err != nil {
+		return err
+	}
+
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	list, err := ds.Actions(id, opt)
+	if err != nil {
+		return err
+	}
+
+	if actionType != "" || status != "" {
+		filtered := do.Actions{}
+		for _, a := range list {
+			if actionType != "" && a.Type != actionType {
+				continue
+			}
+			if status != "" && a.Status != status {
+				continue
+			}
+			filtered = append(filtered, a)
+		}
+		list = filtered
+	}
+
 	item := &action{actions: list}
 	return c.Display(item)
 }
@@ -130,7 +179,12 @@ func RunDropletBackups(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := ds.Backups(id)
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	list, err := ds.Backups(id, opt)
 	if err != nil {
 		return err
 	}
@@ -141,9 +195,38 @@ func RunDropletBackups(c *CmdConfig) error {
 
 // RunDropletCreate creates a droplet.
 func RunDropletCreate(c *CmdConfig) error {
+	wait, err := c.Doit.GetBool(c.NS, doctl.ArgCommandWait)
+	if err != nil {
+		return err
+	}
 
-	if len(c.Args) < 1 {
-		return doctl.NewMissingArgsErr(c.NS)
+	waitTimeout, err := c.Doit.GetInt(c.NS, doctl.ArgWaitTimeout)
+	if err != nil {
+		return err
+	}
+	timeout := waitTimeoutOrForever(waitTimeout)
+
+	specFile, err := c.Doit.GetString(c.NS, doctl.ArgDropletFromFile)
+	if err != nil {
+		return err
+	}
+
+	if specFile != "" {
+		if len(c.Args) > 0 {
+			return fmt.Errorf("droplet names can't be combined with --%s", doctl.ArgDropletFromFile)
+		}
+
+		return runDropletCreateFromFile(c, specFile, wait, timeout)
+	}
+
+	count, err := c.Doit.GetInt(c.NS, doctl.ArgDropletCount)
+	if err != nil {
+		return err
+	}
+
+	nameTemplate, err := c.Doit.GetString(c.NS, doctl.ArgNameTemplate)
+	if err != nil {
+		return err
 	}
 
 	region, err := c.Doit.GetString(c.NS, doctl.ArgRegionSlug)
@@ -156,6 +239,17 @@ func RunDropletCreate(c *CmdConfig) error {
 		return err
 	}
 
+	if region == autoRegion {
+		if region, err = closestRegion(c, size); err != nil {
+			return err
+		}
+	}
+
+	names, err := dropletCreateNames(c.NS, c.Args, count, nameTemplate, region)
+	if err != nil {
+		return err
+	}
+
 	backups, err := c.Doit.GetBool(c.NS, doctl.ArgBackups)
 	if err != nil {
 		return err
@@ -181,6 +275,11 @@ func RunDropletCreate(c *CmdConfig) error {
 		return err
 	}
 
+	tagNames, err := c.Doit.GetStringSlice(c.NS, doctl.ArgTagNames)
+	if err != nil {
+		return err
+	}
+
 	sshKeys := extractSSHKeys(keys)
 
 	userData, err := c.Doit.GetString(c.NS, doctl.ArgUserData)
@@ -204,30 +303,36 @@ func RunDropletCreate(c *CmdConfig) error {
 		return err
 	}
 
-	imageStr, err := c.Doit.GetString(c.NS, doctl.ArgImage)
+	userDataVars, err := c.Doit.GetStringSlice(c.NS, doctl.ArgUserDataVars)
 	if err != nil {
 		return err
 	}
 
-	createImage := godo.DropletCreateImage{Slug: imageStr}
-
-	i, err := strconv.Atoi(imageStr)
-	if err == nil {
-		createImage = godo.DropletCreateImage{ID: i}
+	if len(userDataVars) > 0 {
+		userData, err = renderUserData(userData, userDataVars)
+		if err != nil {
+			return err
+		}
 	}
 
-	wait, err := c.Doit.GetBool(c.NS, doctl.ArgCommandWait)
+	imageStr, err := c.Doit.GetString(c.NS, doctl.ArgImage)
 	if err != nil {
 		return err
 	}
 
-	ds := c.Droplets()
-	ts := c.Tags()
+	createImage := extractImage(imageStr)
 
-	var wg sync.WaitGroup
-	var createdList do.Droplets
-	errs := make(chan error, len(c.Args))
-	for _, name := range c.Args {
+	var tags []string
+	seenTags := map[string]bool{}
+	for _, t := range append([]string{tagName}, tagNames...) {
+		if t != "" && !seenTags[t] {
+			seenTags[t] = true
+			tags = append(tags, t)
+		}
+	}
+
+	var creates []dropletCreate
+	for _, name := range names {
 		dcr := &godo.DropletCreateRequest{
 			Name:              name,
 			Region:            region,
@@ -241,16 +346,443 @@ func RunDropletCreate(c *CmdConfig) error {
 			UserData:          userData,
 		}
 
+		creates = append(creates, dropletCreate{req: dcr, tags: tags})
+	}
+
+	return createDroplets(c, creates, wait, timeout)
+}
+
+// createMissingTags creates the tags the droplets will be tagged with, so
+// new tags don't fail the create. Tags that already exist are left alone.
+func createMissingTags(c *CmdConfig, creates []dropletCreate) error {
+	noCreate, err := c.Doit.GetBool(c.NS, doctl.ArgNoCreateTags)
+	if err != nil {
+		return err
+	}
+	if noCreate {
+		return nil
+	}
+
+	ts := c.Tags()
+	seen := map[string]bool{}
+	for _, dc := range creates {
+		for _, name := range dc.tags {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			_, err := ts.Create(&godo.TagCreateRequest{Name: name})
+			if err != nil && !isUnprocessable(err) {
+				return fmt.Errorf("unable to create tag %q: %v", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkSizeAvailability makes sure each requested size is available in the
+// requested region, so an unavailable combination fails before any droplet
+// is created.
+func checkSizeAvailability(c *CmdConfig, creates []dropletCreate) error {
+	sizes, err := c.Sizes().List(nil)
+	if err != nil {
+		return err
+	}
+
+	bySlug := map[string]do.Size{}
+	for _, s := range sizes {
+		bySlug[s.Slug] = s
+	}
+
+	for _, dc := range creates {
+		size, ok := bySlug[dc.req.Size]
+		if !ok {
+			return fmt.Errorf("size %q does not exist", dc.req.Size)
+		}
+
+		if !size.Available {
+			return fmt.Errorf("size %q is not available", dc.req.Size)
+		}
+
+		found := false
+		for _, r := range size.Regions {
+			if r == dc.req.Region {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("size %q is not available in region %q, available regions: %s",
+				dc.req.Size, dc.req.Region, strings.Join(size.Regions, ", "))
+		}
+	}
+
+	return nil
+}
+
+// autoRegion is the --region value that selects the closest region.
+const autoRegion = "auto"
+
+// regionProbeTimeout bounds each region latency probe.
+var regionProbeTimeout = 2 * time.Second
+
+// regionLatency measures the round trip time to a region. It is a variable
+// so tests can avoid the network.
+var regionLatency = func(slug string) (time.Duration, error) {
+	client := &http.Client{Timeout: regionProbeTimeout}
+
+	start := time.Now()
+	resp, err := client.Head(fmt.Sprintf("http://speedtest-%s.digitalocean.com/", slug))
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+
+	return time.Since(start), nil
+}
+
+// closestRegion returns the available region offering size with the lowest
+// latency. If no region can be probed the first candidate by slug is used,
+// so the choice is still deterministic.
+func closestRegion(c *CmdConfig, size string) (string, error) {
+	if size == "" {
+		return "", fmt.Errorf("--%s is required with --%s %s", doctl.ArgSizeSlug, doctl.ArgRegionSlug, autoRegion)
+	}
+
+	sizes, err := c.Sizes().List(nil)
+	if err != nil {
+		return "", err
+	}
+
+	offered := map[string]bool{}
+	found := false
+	for _, s := range sizes {
+		if s.Slug == size {
+			found = true
+			for _, r := range s.Regions {
+				offered[r] = true
+			}
+		}
+	}
+	if !found {
+		return "", fmt.Errorf("size %q does not exist", size)
+	}
+
+	regions, err := c.Regions().List(nil)
+	if err != nil {
+		return "", err
+	}
+
+	var candidates []string
+	for _, r := range regions {
+		if r.Available && offered[r.Slug] {
+			candidates = append(candidates, r.Slug)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("size %q is not available in any region", size)
+	}
+	sort.Strings(candidates)
+
+	latencies := make([]time.Duration, len(candidates))
+	probed := make([]bool, len(candidates))
+	var wg sync.WaitGroup
+	for i, slug := range candidates {
+		wg.Add(1)
+		go func(i int, slug string) {
+			defer wg.Done()
+			if d, err := regionLatency(slug); err == nil {
+				latencies[i], probed[i] = d, true
+			}
+		}(i, slug)
+	}
+	wg.Wait()
+
+	best := -1
+	for i := range candidates {
+		if probed[i] && (best < 0 || latencies[i] < latencies[best]) {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		warn(fmt.Sprintf("unable to measure region latency, using %s", candidates[0]))
+		return candidates[0], nil
+	}
+
+	notice(fmt.Sprintf("using region %s (%s)", candidates[best], latencies[best]/time.Millisecond*time.Millisecond))
+	return candidates[best], nil
+}
+
+// resolveImageNames replaces image slugs in the create requests which are
+// not public slugs with the id of the user image (snapshot or backup) of
+// that name.
+func resolveImageNames(c *CmdConfig, creates []dropletCreate) error {
+	r := newImageResolver(c.Images())
+
+	for _, dc := range creates {
+		name := dc.req.Image.Slug
+		if name == "" || r.isSlug(name) {
+			continue
+		}
+
+		id, err := r.byName(name, dc.req.Region)
+		if err != nil {
+			return err
+		}
+
+		// leave it to the API to report an unknown slug.
+		if id != 0 {
+			dc.req.Image = godo.DropletCreateImage{ID: id}
+		}
+	}
+
+	return nil
+}
+
+// imageResolver looks up user images (snapshots and backups) by name. Slug
+// lookups are cached and the user's images are listed at most once.
+type imageResolver struct {
+	is         do.ImagesService
+	slugs      map[string]bool
+	userImages do.Images
+	listed     bool
+}
+
+func newImageResolver(is do.ImagesService) *imageResolver {
+	return &imageResolver{is: is, slugs: map[string]bool{}}
+}
+
+// isSlug reports whether name is the slug of a public image.
+func (r *imageResolver) isSlug(name string) bool {
+	known, ok := r.slugs[name]
+	if !ok {
+		_, err := r.is.GetBySlug(name)
+		known = err == nil
+		r.slugs[name] = known
+	}
+	return known
+}
+
+// byName returns the id of the user image called name, or 0 if there is
+// none. If several images have the name, the ones available in region are
+// preferred.
+func (r *imageResolver) byName(name, region string) (int, error) {
+	if !r.listed {
+		var err error
+		if r.userImages, err = r.is.ListUser(false, nil); err != nil {
+			return 0, err
+		}
+		r.listed = true
+	}
+
+	var matches do.Images
+	for _, i := range r.userImages {
+		if i.Name == name {
+			matches = append(matches, i)
+		}
+	}
+
+	if len(matches) > 1 {
+		var inRegion do.Images
+		for _, i := range matches {
+			for _, ir := range i.Regions {
+				if ir == region {
+					inRegion = append(inRegion, i)
+					break
+				}
+			}
+		}
+		if len(inRegion) > 0 {
+			matches = inRegion
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, nil
+	case 1:
+		return matches[0].ID, nil
+	default:
+		var ids []string
+		for _, i := range matches {
+			ids = append(ids, strconv.Itoa(i.ID))
+		}
+		return 0, fmt.Errorf("image name %q is ambiguous, use one of the ids: %s",
+			name, strings.Join(ids, ", "))
+	}
+}
+
+// resolveVolumeNames replaces volume names in the create requests with the
+// id of the volume with that name in the droplet's region.
+func resolveVolumeNames(c *CmdConfig, creates []dropletCreate) error {
+	var volumes []do.Volume
+	listed := false
+
+	for _, dc := range creates {
+		for i, v := range dc.req.Volumes {
+			if v.Name == "" {
+				continue
+			}
+
+			if !listed {
+				var err error
+				if volumes, err = c.Volumes().List(nil); err != nil {
+					return err
+				}
+				listed = true
+			}
+
+			var ids, otherRegions []string
+			for _, vol := range volumes {
+				if vol.Name != v.Name {
+					continue
+				}
+				if vol.Region != nil && vol.Region.Slug == dc.req.Region {
+					ids = append(ids, vol.ID)
+				} else if vol.Region != nil {
+					otherRegions = append(otherRegions, vol.Region.Slug)
+				}
+			}
+
+			switch {
+			case len(ids) == 1:
+				dc.req.Volumes[i] = godo.DropletCreateVolume{ID: ids[0]}
+			case len(ids) > 1:
+				return fmt.Errorf("volume name %q is ambiguous in region %q, use one of the ids: %s",
+					v.Name, dc.req.Region, strings.Join(ids, ", "))
+			case len(otherRegions) > 0:
+				return fmt.Errorf("volume %q is not in region %q, found in: %s",
+					v.Name, dc.req.Region, strings.Join(otherRegions, ", "))
+			default:
+				return fmt.Errorf("volume %q does not exist", v.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveSSHKeyNames replaces ssh key names in the create requests with the
+// key's id. Keys given as an id or a fingerprint are left unchanged.
+func resolveSSHKeyNames(c *CmdConfig, creates []dropletCreate) error {
+	var keys do.SSHKeys
+	listed := false
+
+	for _, dc := range creates {
+		for i, k := range dc.req.SSHKeys {
+			if k.Fingerprint == "" || strings.Contains(k.Fingerprint, ":") {
+				continue
+			}
+			name := k.Fingerprint
+
+			if !listed {
+				var err error
+				if keys, err = c.Keys().List(nil); err != nil {
+					return err
+				}
+				listed = true
+			}
+
+			var ids []int
+			for _, key := range keys {
+				if key.Name == name {
+					ids = append(ids, key.ID)
+				}
+			}
+
+			switch len(ids) {
+			case 1:
+				dc.req.SSHKeys[i] = godo.DropletCreateSSHKey{ID: ids[0]}
+			case 0:
+				return fmt.Errorf("ssh key %q does not exist", name)
+			default:
+				var idStrs []string
+				for _, id := range ids {
+					idStrs = append(idStrs, strconv.Itoa(id))
+				}
+				return fmt.Errorf("ssh key name %q is ambiguous, use one of the ids: %s",
+					name, strings.Join(idStrs, ", "))
+			}
+		}
+	}
+
+	return nil
+}
+
+// dropletCreate is a droplet create request and the tags to apply once the
+// droplet has been created.
+type dropletCreate struct {
+	req  *godo.DropletCreateRequest
+	tags []string
+}
+
+// createDroplets creates droplets concurrently and displays the ones which
+// were created.
+func createDroplets(c *CmdConfig, creates []dropletCreate, wait bool, timeout time.Duration) error {
+	if err := checkColumns(c.NS, c.Doit, &droplet{}); err != nil {
+		return err
+	}
+
+	noPreflight, err := c.Doit.GetBool(c.NS, doctl.ArgNoPreflight)
+	if err != nil {
+		return err
+	}
+
+	if !noPreflight {
+		if err := checkSizeAvailability(c, creates); err != nil {
+			return err
+		}
+	}
+
+	if err := resolveImageNames(c, creates); err != nil {
+		return err
+	}
+
+	if err := resolveVolumeNames(c, creates); err != nil {
+		return err
+	}
+
+	if err := resolveSSHKeyNames(c, creates); err != nil {
+		return err
+	}
+
+	if err := createMissingTags(c, creates); err != nil {
+		return err
+	}
+
+	ds := c.Droplets()
+	as := c.Actions()
+	ts := c.Tags()
+
+	var wg sync.WaitGroup
+	created := make([]*do.Droplet, len(creates))
+	// Each droplet can fail to create or to become active, and fail to be
+	// tagged with each tag.
+	maxErrs := 0
+	for _, dc := range creates {
+		maxErrs += 1 + len(dc.tags)
+	}
+	errs := make(chan error, maxErrs)
+	for i, dc := range creates {
+		i, dc := i, dc
+
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			d, err := ds.Create(dcr, wait)
+			d, err := ds.Create(dc.req)
 			if err != nil {
 				errs <- err
 				return
 			}
 
-			if tagName != "" {
+			// the droplet exists from here on, so it is tagged and shown
+			// even if waiting for it fails.
+			created[i] = d
+
+			for _, tagName := range dc.tags {
 				trr := &godo.TagResourcesRequest{
 					Resources: []godo.Resource{
 						{ID: strconv.Itoa(d.ID), Type: godo.DropletResourceType},
@@ -261,18 +793,34 @@ func RunDropletCreate(c *CmdConfig) error {
 				if err != nil {
 					errs <- err
 				}
-
 			}
 
-			createdList = append(createdList, *d)
+			if wait {
+				active, err := waitForDropletActive(ds, as, d.ID, timeout)
+				if err != nil {
+					errs <- err
+					return
+				}
+				created[i] = active
+			}
 		}()
 	}
 
 	wg.Wait()
 	close(errs)
 
+	// Keep the output in the order the droplets were requested in.
+	createdList := do.Droplets{}
+	for _, d := range created {
+		if d != nil {
+			createdList = append(createdList, *d)
+		}
+	}
+
 	item := &droplet{droplets: createdList}
-	c.Display(item)
+	if err := c.Display(item); err != nil {
+		return err
+	}
 
 	for err := range errs {
 		if err != nil {
@@ -283,6 +831,159 @@ func RunDropletCreate(c *CmdConfig) error {
 	return nil
 }
 
+// dropletSpec is a droplet definition in a droplet spec file.
+type dropletSpec struct {
+	Name              string   `yaml:"name" json:"name"`
+	Region            string   `yaml:"region" json:"region"`
+	Size              string   `yaml:"size" json:"size"`
+	Image             string   `yaml:"image" json:"image"`
+	SSHKeys           []string `yaml:"ssh_keys" json:"ssh_keys,omitempty"`
+	Backups           bool     `yaml:"backups" json:"backups,omitempty"`
+	IPv6              bool     `yaml:"ipv6" json:"ipv6,omitempty"`
+	PrivateNetworking bool     `yaml:"private_networking" json:"private_networking,omitempty"`
+	UserData          string   `yaml:"user_data" json:"user_data,omitempty"`
+	Volumes           []string `yaml:"volumes" json:"volumes,omitempty"`
+	Tags              []string `yaml:"tags" json:"tags,omitempty"`
+}
+
+var dropletSpecFields = map[string]bool{
+	"name": true, "region": true, "size": true, "image": true,
+	"ssh_keys": true, "backups": true, "ipv6": true, "private_networking": true,
+	"user_data": true, "volumes": true, "tags": true,
+}
+
+// parseDropletSpecs parses a YAML or JSON list of droplet specs. Unknown
+// fields and specs missing required fields are rejected.
+func parseDropletSpecs(in []byte) ([]dropletSpec, error) {
+	var raw []map[string]interface{}
+	if err := yaml.Unmarshal(in, &raw); err != nil {
+		return nil, fmt.Errorf("unable to parse droplet spec: %v", err)
+	}
+
+	for i, r := range raw {
+		for k := range r {
+			if !dropletSpecFields[k] {
+				return nil, fmt.Errorf("droplet spec %d: unknown field %q", i+1, k)
+			}
+		}
+	}
+
+	var specs []dropletSpec
+	if err := yaml.Unmarshal(in, &specs); err != nil {
+		return nil, fmt.Errorf("unable to parse droplet spec: %v", err)
+	}
+
+	for i, spec := range specs {
+		var missing []string
+		if spec.Name == "" {
+			missing = append(missing, "name")
+		}
+		if spec.Region == "" {
+			missing = append(missing, "region")
+		}
+		if spec.Size == "" {
+			missing = append(missing, "size")
+		}
+		if spec.Image == "" {
+			missing = append(missing, "image")
+		}
+
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("droplet spec %d: missing required field(s) %s",
+				i+1, strings.Join(missing, ", "))
+		}
+	}
+
+	return specs, nil
+}
+
+func (spec dropletSpec) createRequest() *godo.DropletCreateRequest {
+	return &godo.DropletCreateRequest{
+		Name:              spec.Name,
+		Region:            spec.Region,
+		Size:              spec.Size,
+		Image:             extractImage(spec.Image),
+		SSHKeys:           extractSSHKeys(spec.SSHKeys),
+		Backups:           spec.Backups,
+		IPv6:              spec.IPv6,
+		PrivateNetworking: spec.PrivateNetworking,
+		UserData:          spec.UserData,
+		Volumes:           extractVolumes(spec.Volumes),
+	}
+}
+
+func runDropletCreateFromFile(c *CmdConfig, specFile string, wait bool, timeout time.Duration) error {
+	dryRun, err := c.Doit.GetBool(c.NS, doctl.ArgDryRun)
+	if err != nil {
+		return err
+	}
+
+	in, err := ioutil.ReadFile(specFile)
+	if err != nil {
+		return err
+	}
+
+	specs, err := parseDropletSpecs(in)
+	if err != nil {
+		return err
+	}
+
+	var creates []dropletCreate
+	for _, spec := range specs {
+		creates = append(creates, dropletCreate{req: spec.createRequest(), tags: spec.Tags})
+	}
+
+	if dryRun {
+		var reqs []*godo.DropletCreateRequest
+		for _, dc := range creates {
+			reqs = append(reqs, dc.req)
+		}
+
+		if err := writeJSON(reqs, c.Out); err != nil {
+			return err
+		}
+		fmt.Fprintln(c.Out)
+		return nil
+	}
+
+	return createDroplets(c, creates, wait, timeout)
+}
+
+// waitForDropletActive waits for the create action of a droplet to
+// complete and returns the refreshed droplet.
+func waitForDropletActive(ds do.DropletsService, as do.ActionsService, id int, timeout time.Duration) (*do.Droplet, error) {
+	actions, err := ds.Actions(id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var createAction *do.Action
+	for i := range actions {
+		if actions[i].Type == "create" {
+			createAction = &actions[i]
+			break
+		}
+	}
+
+	if createAction == nil {
+		return nil, fmt.Errorf("unable to find create action for droplet %d", id)
+	}
+
+	a, err := waitForActive(context.Background(), as, createAction.ID, timeout)
+	if _, timedOut := err.(*waitTimeoutError); timedOut {
+		return nil, fmt.Errorf("droplet %d created but not active after %s", id, timeout)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("droplet %d: %v", id, err)
+	}
+
+	if a.Status != godo.ActionCompleted {
+		return nil, fmt.Errorf("droplet %d create action finished with status %q", id, a.Status)
+	}
+
+	return ds.Get(id)
+}
+
 // RunDropletTag adds a tag to a droplet.
 func RunDropletTag(c *CmdConfig) error {
 	ds := c.Droplets()
@@ -354,6 +1055,9 @@ func RunDropletUntag(c *CmdConfig) error {
 	return matchDroplets(dropletIDStrs, ds, fn)
 }
 
+// extractSSHKeys converts ssh key ids, fingerprints and names into create
+// request keys. Names are kept in the fingerprint field until they are
+// resolved by resolveSSHKeyNames.
 func extractSSHKeys(keys []string) []godo.DropletCreateSSHKey {
 	sshKeys := []godo.DropletCreateSSHKey{}
 
@@ -373,6 +1077,14 @@ func extractSSHKeys(keys []string) []godo.DropletCreateSSHKey {
 	return sshKeys
 }
 
+func extractImage(imageStr string) godo.DropletCreateImage {
+	if i, err := strconv.Atoi(imageStr); err == nil {
+		return godo.DropletCreateImage{ID: i}
+	}
+
+	return godo.DropletCreateImage{Slug: imageStr}
+}
+
 func extractUserData(userData, filename string) (string, error) {
 	if userData == "" && filename != "" {
 		data, err := ioutil.ReadFile(filename)
@@ -385,6 +1097,101 @@ func extractUserData(userData, filename string) (string, error) {
 	return userData, nil
 }
 
+// dropletCreateNames returns the names of the droplets to create. With
+// --count the droplets are named after the single NAME argument, either as
+// NAME-1, NAME-2, ... or by rendering --name-template for each of them.
+func dropletCreateNames(ns string, args []string, count int, nameTemplate, region string) ([]string, error) {
+	if count == 0 {
+		if nameTemplate != "" {
+			return nil, fmt.Errorf("--%s requires --%s", doctl.ArgNameTemplate, doctl.ArgDropletCount)
+		}
+		if len(args) < 1 {
+			return nil, doctl.NewMissingArgsErr(ns)
+		}
+		return args, nil
+	}
+
+	if count < 0 {
+		return nil, fmt.Errorf("--%s must be greater than zero", doctl.ArgDropletCount)
+	}
+	if len(args) > 1 {
+		return nil, fmt.Errorf("--%s takes a single droplet name", doctl.ArgDropletCount)
+	}
+
+	var base string
+	if len(args) == 1 {
+		base = args[0]
+	}
+
+	var names []string
+	if nameTemplate == "" {
+		if base == "" {
+			return nil, doctl.NewMissingArgsErr(ns)
+		}
+		for i := 1; i <= count; i++ {
+			names = append(names, fmt.Sprintf("%s-%d", base, i))
+		}
+		return names, nil
+	}
+
+	t, err := template.New("name").Parse(nameTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse name template: %v", err)
+	}
+
+	seen := map[string]int{}
+	for i := 1; i <= count; i++ {
+		data := struct {
+			Name   string
+			Index  int
+			Region string
+		}{Name: base, Index: i, Region: region}
+
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("unable to render name template: %v", err)
+		}
+
+		name := strings.TrimSpace(buf.String())
+		if name == "" {
+			return nil, fmt.Errorf("name template renders an empty name for droplet %d", i)
+		}
+		if j, ok := seen[name]; ok {
+			return nil, fmt.Errorf("name template renders %q for both droplet %d and %d", name, j, i)
+		}
+		seen[name] = i
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// renderUserData executes user data as a Go template with the given
+// key=value variables. Referencing an undefined variable is an error.
+func renderUserData(userData string, vars []string) (string, error) {
+	data := map[string]string{}
+	for _, v := range vars {
+		parts := strings.SplitN(v, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return "", fmt.Errorf("user data variable %q must be in the form key=value", v)
+		}
+		data[parts[0]] = parts[1]
+	}
+
+	t, err := template.New("user-data").Option("missingkey=error").Parse(userData)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse user data template: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("unable to render user data template: %v", err)
+	}
+
+	return buf.String(), nil
+}
+
 func extractVolumes(volumeList []string) []godo.DropletCreateVolume {
 	var volumes []godo.DropletCreateVolume
 
@@ -444,29 +1251,106 @@ func RunDropletDelete(c *CmdConfig) error {
 	} else if tagName != "" {
 		if force || AskForConfirm("delete droplet by \""+tagName+"\" tag") == nil {
 			return ds.DeleteByTag(tagName)
-		} else {
-			return fmt.Errorf("Operation aborted.")
 		}
-		return nil
+		return fmt.Errorf("Operation aborted.")
 	}
 
-	if force || AskForConfirm("delete droplet(s)") == nil {
+	maxConcurrency, err := c.Doit.GetInt(c.NS, doctl.ArgMaxConcurrency)
+	if err != nil {
+		return err
+	}
 
+	if force || AskForConfirm("delete droplet(s)") == nil {
 		fn := func(ids []int) error {
-			for _, id := range ids {
-				if err := ds.Delete(id); err != nil {
-					return fmt.Errorf("unable to delete droplet %d: %v", id, err)
-				}
-			}
-			return nil
+			return deleteDroplets(ds, ids, maxConcurrency, c.Out)
 		}
 		return matchDroplets(c.Args, ds, fn)
-	} else {
-		return fmt.Errorf("Operation aborted.")
 	}
 
-	return nil
+	return fmt.Errorf("Operation aborted.")
+}
 
+// deleteDroplets deletes droplets using at most maxConcurrency workers. A
+// failed delete doesn't stop the remaining ones; all failures are reported
+// in the returned error.
+func deleteDroplets(ds do.DropletsService, ids []int, maxConcurrency int, out io.Writer) error {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	type deleteResult struct {
+		id  int
+		err error
+	}
+
+	idChan := make(chan int)
+	resultChan := make(chan deleteResult, len(ids))
+
+	var wg sync.WaitGroup
+	for i := 0; i < maxConcurrency && i < len(ids); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range idChan {
+				resultChan <- deleteResult{id: id, err: ds.Delete(id)}
+			}
+		}()
+	}
+
+	for _, id := range ids {
+		idChan <- id
+	}
+	close(idChan)
+
+	wg.Wait()
+	close(resultChan)
+
+	var deleted []int
+	failed := map[int]error{}
+	for r := range resultChan {
+		if r.err != nil {
+			failed[r.id] = r.err
+			continue
+		}
+		deleted = append(deleted, r.id)
+	}
+
+	if len(ids) == 1 && len(failed) == 1 {
+		return fmt.Errorf("unable to delete droplet %d: %v", ids[0], failed[ids[0]])
+	}
+
+	if len(ids) > 1 {
+		sort.Ints(deleted)
+		fmt.Fprintf(out, "Deleted droplets: %s\n", joinInts(deleted))
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+
+	var failedIDs []int
+	for id := range failed {
+		failedIDs = append(failedIDs, id)
+	}
+	sort.Ints(failedIDs)
+
+	fmt.Fprintf(out, "Failed droplets: %s\n", joinInts(failedIDs))
+
+	msgs := []string{}
+	for _, id := range failedIDs {
+		msgs = append(msgs, fmt.Sprintf("droplet %d: %v", id, failed[id]))
+	}
+
+	return fmt.Errorf("unable to delete %d droplet(s):\n%s", len(failedIDs), strings.Join(msgs, "\n"))
+}
+
+func joinInts(ids []int) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = strconv.Itoa(id)
+	}
+
+	return strings.Join(strs, ", ")
 }
 
 type matchDropletsFn func(ids []int) error
@@ -519,11 +1403,6 @@ func RunDropletGet(c *CmdConfig) error {
 		return err
 	}
 
-	getTemplate, err := c.Doit.GetString(c.NS, doctl.ArgTemplate)
-	if err != nil {
-		return err
-	}
-
 	ds := c.Droplets()
 
 	d, err := ds.Get(id)
@@ -532,14 +1411,6 @@ func RunDropletGet(c *CmdConfig) error {
 	}
 
 	item := &droplet{droplets: do.Droplets{*d}}
-	if getTemplate != "" {
-		t := template.New("get template")
-		t, err = t.Parse(getTemplate)
-		if err != nil {
-			return err
-		}
-		return t.Execute(c.Out, item)
-	}
 	return c.Display(item)
 }
 
@@ -552,7 +1423,12 @@ func RunDropletKernels(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := ds.Kernels(id)
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	list, err := ds.Kernels(id, opt)
 	if err != nil {
 		return err
 	}
@@ -576,6 +1452,19 @@ func RunDropletList(c *CmdConfig) error {
 		return err
 	}
 
+	image, err := c.Doit.GetString(c.NS, doctl.ArgImage)
+	if err != nil {
+		return err
+	}
+
+	if tagName != "" {
+		for _, arg := range c.Args {
+			if _, err := strconv.Atoi(arg); err == nil {
+				return fmt.Errorf("droplet ids can't be combined with --%s", doctl.ArgTagName)
+			}
+		}
+	}
+
 	matches := []glob.Glob{}
 	for _, globStr := range c.Args {
 		g, err := glob.Compile(globStr)
@@ -588,14 +1477,20 @@ func RunDropletList(c *CmdConfig) error {
 
 	var matchedList do.Droplets
 
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	// filter by tag on the server so only the tagged droplets are paged.
 	var list do.Droplets
 	if tagName == "" {
-		list, err = ds.List()
-		if err != nil {
-			return err
-		}
+		list, err = ds.List(opt)
 	} else {
-		list, err = ds.ListByTag(tagName)
+		list, err = ds.ListByTag(tagName, opt)
+	}
+	if err != nil {
+		return err
 	}
 
 	for _, droplet := range list {
@@ -616,6 +1511,12 @@ func RunDropletList(c *CmdConfig) error {
 			}
 		}
 
+		if !skip && image != "" {
+			if droplet.Image == nil || (image != droplet.Image.Slug && image != strconv.Itoa(droplet.Image.ID)) {
+				skip = true
+			}
+		}
+
 		if !skip {
 			matchedList = append(matchedList, droplet)
 		}
@@ -630,6 +1531,24 @@ func RunDropletNeighbors(c *CmdConfig) error {
 
 	ds := c.Droplets()
 
+	all, err := c.Doit.GetBool(c.NS, doctl.ArgNeighborsAll)
+	if err != nil {
+		return err
+	}
+
+	if all {
+		if len(c.Args) > 0 {
+			return fmt.Errorf("a droplet id can't be combined with --%s", doctl.ArgNeighborsAll)
+		}
+
+		groups, err := ds.AllNeighbors()
+		if err != nil {
+			return err
+		}
+
+		return c.Display(&dropletNeighbors{groups: groups})
+	}
+
 	id, err := getDropletIDArg(c.NS, c.Args)
 	if err != nil {
 		return err
@@ -653,7 +1572,12 @@ func RunDropletSnapshots(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := ds.Snapshots(id)
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	list, err := ds.Snapshots(id, opt)
 	if err != nil {
 		return err
 	}
@@ -677,7 +1601,7 @@ type dropletSummary struct {
 }
 
 func buildDropletSummary(ds do.DropletsService) (*dropletSummary, error) {
-	list, err := ds.List()
+	list, err := ds.List(nil)
 	if err != nil {
 		return nil, err
 	}
@@ -14,14 +14,20 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -36,6 +42,11 @@ var (
 		Regions: []string{"test0"},
 	}}
 	testImageList = do.Images{testImage, testImageSecondary}
+
+	testAvailableSizes = do.Sizes{
+		{Size: &godo.Size{Slug: "512mb", Available: true, Regions: []string{"nyc3"}}},
+		{Size: &godo.Size{Slug: "1gb", Available: true, Regions: []string{"dev0", "nyc3"}}},
+	}
 )
 
 func TestDropletCommand(t *testing.T) {
@@ -46,18 +57,42 @@ func TestDropletCommand(t *testing.T) {
 
 func TestDropletActionList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.On("Actions", 1).Return(testActionList, nil)
+		tm.droplets.On("Actions", 1, allPages).Return(testActionList, nil)
+
+		config.Args = append(config.Args, "1")
+
+		err := RunDropletActions(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletActionsListFilters(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		actions := do.Actions{
+			{Action: &godo.Action{ID: 1, Type: "power_on", Status: "completed"}},
+			{Action: &godo.Action{ID: 2, Type: "reboot", Status: "completed"}},
+			{Action: &godo.Action{ID: 3, Type: "reboot", Status: "errored"}},
+			{Action: &godo.Action{ID: 4, Type: "reboot", Status: "completed"}},
+		}
+		tm.droplets.On("Actions", 1, allPages).Return(actions, nil)
 
+		var buf bytes.Buffer
+		config.Out = &buf
 		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgActionType, "reboot")
+		config.Doit.Set(config.NS, doctl.ArgActionStatus, "completed")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
 
 		err := RunDropletActions(config)
 		assert.NoError(t, err)
+		assert.Equal(t, "2\n4\n", buf.String())
 	})
 }
 
 func TestDropletBackupList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.On("Backups", 1).Return(testImageList, nil)
+		tm.droplets.On("Backups", 1, allPages).Return(testImageList, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -68,7 +103,11 @@ func TestDropletBackupList(t *testing.T) {
 
 func TestDropletCreate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
 		volumeUUID := uuid.New()
+		devVolume := do.Volume{Volume: &godo.Volume{ID: uuid.New(), Name: "test-volume", Region: &godo.Region{Slug: "dev0"}}}
+		tm.volumes.On("List", allPages).Return([]do.Volume{testVolume, devVolume}, nil)
 		dcr := &godo.DropletCreateRequest{
 			Name:    "droplet",
 			Region:  "dev0",
@@ -76,7 +115,7 @@ func TestDropletCreate(t *testing.T) {
 			Image:   godo.DropletCreateImage{ID: 0, Slug: "image"},
 			SSHKeys: []godo.DropletCreateSSHKey{},
 			Volumes: []godo.DropletCreateVolume{
-				{Name: "test-volume"},
+				{ID: devVolume.ID},
 				{ID: volumeUUID},
 			},
 			Backups:           false,
@@ -84,7 +123,7 @@ func TestDropletCreate(t *testing.T) {
 			PrivateNetworking: false,
 			UserData:          "#cloud-config",
 		}
-		tm.droplets.On("Create", dcr, false).Return(&testDroplet, nil)
+		tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
 
 		config.Args = append(config.Args, "droplet")
 
@@ -99,16 +138,226 @@ func TestDropletCreate(t *testing.T) {
 	})
 }
 
+func TestDropletCreateFormat(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+		for i, name := range []string{"web-1", "web-2", "web-3"} {
+			name := name
+			gd := *testDroplet.Droplet
+			gd.ID, gd.Name = i+10, name
+			d := &do.Droplet{Droplet: &gd}
+			tm.droplets.On("Create", mock.MatchedBy(func(r *godo.DropletCreateRequest) bool {
+				return r.Name == name
+			})).Return(d, nil)
+		}
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "web-1", "web-2", "web-3")
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name,ID")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "web-1\t10\nweb-2\t11\nweb-3\t12\n", buf.String())
+	})
+}
+
+func TestDropletCreateUnknownColumn(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "droplet")
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name,PublicIP")
+
+		err := RunDropletCreate(config)
+		assert.EqualError(t, err, `unknown column "PublicIP"`)
+	})
+}
+
+func TestDropletCreateVolumeNames(t *testing.T) {
+	dev0 := &godo.Region{Slug: "dev0"}
+	cases := []struct {
+		volumes []do.Volume
+		err     string
+	}{
+		{
+			volumes: []do.Volume{testVolume},
+			err:     `volume "test-volume" is not in region "dev0", found in: atlantis`,
+		},
+		{
+			volumes: []do.Volume{
+				{Volume: &godo.Volume{ID: "a", Name: "test-volume", Region: dev0}},
+				{Volume: &godo.Volume{ID: "b", Name: "test-volume", Region: dev0}},
+			},
+			err: `volume name "test-volume" is ambiguous in region "dev0", use one of the ids: a, b`,
+		},
+		{
+			err: `volume "test-volume" does not exist`,
+		},
+	}
+
+	for _, tc := range cases {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+			tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+			tm.volumes.On("List", allPages).Return(tc.volumes, nil)
+
+			config.Args = append(config.Args, "droplet")
+
+			config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+			config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+			config.Doit.Set(config.NS, doctl.ArgImage, "image")
+			config.Doit.Set(config.NS, doctl.ArgVolumeList, []string{"test-volume"})
+
+			err := RunDropletCreate(config)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestDropletCreateSSHKeyNames(t *testing.T) {
+	keys := do.SSHKeys{
+		{Key: &godo.Key{ID: 10, Name: "laptop"}},
+		{Key: &godo.Key{ID: 11, Name: "ci"}},
+		{Key: &godo.Key{ID: 12, Name: "ci"}},
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+		tm.keys.On("List", allPages).Return(keys, nil)
+		dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "dev0", Size: "1gb", Image: godo.DropletCreateImage{Slug: "image"},
+			SSHKeys: []godo.DropletCreateSSHKey{{ID: 1}, {Fingerprint: "aa:bb:cc"}, {ID: 10}}}
+		tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgSSHKeys, []string{"1", "aa:bb:cc", "laptop"})
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+
+	for name, msg := range map[string]string{
+		"ci":      `ssh key name "ci" is ambiguous, use one of the ids: 11, 12`,
+		"desktop": `ssh key "desktop" does not exist`,
+	} {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+			tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+			tm.keys.On("List", allPages).Return(keys, nil)
+
+			config.Args = append(config.Args, "droplet")
+
+			config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+			config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+			config.Doit.Set(config.NS, doctl.ArgImage, "image")
+			config.Doit.Set(config.NS, doctl.ArgSSHKeys, []string{name})
+
+			err := RunDropletCreate(config)
+			assert.EqualError(t, err, msg)
+		})
+	}
+}
+
+func TestDropletCreateImageName(t *testing.T) {
+	userImages := do.Images{
+		{Image: &godo.Image{ID: 20, Name: "web-base", Regions: []string{"dev0"}}},
+		{Image: &godo.Image{ID: 21, Name: "web-base", Regions: []string{"nyc3"}}},
+		{Image: &godo.Image{ID: 30, Name: "db-base", Regions: []string{"nyc3"}}},
+		{Image: &godo.Image{ID: 31, Name: "db-base", Regions: []string{"sfo1"}}},
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "web-base").Return(nil, fmt.Errorf("not found"))
+		tm.images.On("ListUser", false, allPages).Return(userImages, nil)
+		dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "dev0", Size: "1gb", Image: godo.DropletCreateImage{ID: 20}, SSHKeys: []godo.DropletCreateSSHKey{}}
+		tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "web-base")
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "db-base").Return(nil, fmt.Errorf("not found"))
+		tm.images.On("ListUser", false, allPages).Return(userImages, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "db-base")
+
+		err := RunDropletCreate(config)
+		assert.EqualError(t, err, `image name "db-base" is ambiguous, use one of the ids: 30, 31`)
+	})
+}
+
+func TestDropletCreateSizeNotInRegion(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "sfo1")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+
+		err := RunDropletCreate(config)
+		assert.EqualError(t, err, `size "1gb" is not available in region "sfo1", available regions: dev0, nyc3`)
+		tm.droplets.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
+
+func TestDropletCreateNoPreflight(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+		dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "sfo1", Size: "1gb", Image: godo.DropletCreateImage{ID: 0, Slug: "image"}, SSHKeys: []godo.DropletCreateSSHKey{}}
+		tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "sfo1")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgNoPreflight, true)
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+		tm.sizes.AssertNotCalled(t, "List")
+	})
+}
+
 func TestDropletCreateWithTag(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
 		dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "dev0", Size: "1gb", Image: godo.DropletCreateImage{ID: 0, Slug: "image"}, SSHKeys: []godo.DropletCreateSSHKey{}, Backups: false, IPv6: false, PrivateNetworking: false, UserData: "#cloud-config"}
-		tm.droplets.On("Create", dcr, false).Return(&testDroplet, nil)
+		tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
 
 		trr := &godo.TagResourcesRequest{
 			Resources: []godo.Resource{
 				{ID: "1", Type: godo.DropletResourceType},
 			},
 		}
+		tm.tags.On("Create", &godo.TagCreateRequest{Name: "my-tag"}).Return(&testTag, nil)
 		tm.tags.On("TagResources", "my-tag", trr).Return(nil)
 
 		config.Args = append(config.Args, "droplet")
@@ -124,10 +373,70 @@ func TestDropletCreateWithTag(t *testing.T) {
 	})
 }
 
+func TestDropletCreateTagNames(t *testing.T) {
+	exists := &godo.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusUnprocessableEntity},
+		Message:  "tag already exists",
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+		tm.tags.On("Create", &godo.TagCreateRequest{Name: "web"}).Return(nil, exists)
+		tm.tags.On("Create", &godo.TagCreateRequest{Name: "prod"}).Return(&testTag, nil)
+		tm.droplets.On("Create", mock.Anything).Return(&testDroplet, nil)
+
+		trr := &godo.TagResourcesRequest{
+			Resources: []godo.Resource{
+				{ID: "1", Type: godo.DropletResourceType},
+			},
+		}
+		tm.tags.On("TagResources", "web", trr).Return(nil)
+		tm.tags.On("TagResources", "prod", trr).Return(nil)
+
+		config.Args = append(config.Args, "droplet")
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgTagName, "web")
+		config.Doit.Set(config.NS, doctl.ArgTagNames, []string{"web", "prod"})
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletCreateTagNamesNoCreate(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+		tm.droplets.On("Create", mock.Anything).Return(&testDroplet, nil)
+
+		trr := &godo.TagResourcesRequest{
+			Resources: []godo.Resource{
+				{ID: "1", Type: godo.DropletResourceType},
+			},
+		}
+		tm.tags.On("TagResources", "new", trr).Return(errors.New("tag not found"))
+
+		config.Args = append(config.Args, "droplet")
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgTagNames, []string{"new"})
+		config.Doit.Set(config.NS, doctl.ArgNoCreateTags, true)
+
+		err := RunDropletCreate(config)
+		assert.EqualError(t, err, "tag not found")
+	})
+}
+
 func TestDropletCreateUserDataFile(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
 		dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "dev0", Size: "1gb", Image: godo.DropletCreateImage{ID: 0, Slug: "image"}, SSHKeys: []godo.DropletCreateSSHKey{}, Backups: false, IPv6: false, PrivateNetworking: false, UserData: "#cloud-config\n\ncoreos:\n  etcd2:\n    # generate a new token for each unique cluster from https://discovery.etcd.io/new?size=5\n    # specify the initial size of your cluster with ?size=X\n    discovery: https://discovery.etcd.io/<token>\n    # multi-region and multi-cloud deployments need to use $public_ipv4\n    advertise-client-urls: http://$private_ipv4:2379,http://$private_ipv4:4001\n    initial-advertise-peer-urls: http://$private_ipv4:2380\n    # listen on both the official ports and the legacy ports\n    # legacy ports can be omitted if your application doesn't depend on them\n    listen-client-urls: http://0.0.0.0:2379,http://0.0.0.0:4001\n    listen-peer-urls: http://$private_ipv4:2380\n  units:\n    - name: etcd2.service\n      command: start\n    - name: fleet.service\n      command: start\n"}
-		tm.droplets.On("Create", dcr, false).Return(&testDroplet, nil)
+		tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
 
 		config.Args = append(config.Args, "droplet")
 
@@ -141,6 +450,216 @@ func TestDropletCreateUserDataFile(t *testing.T) {
 	})
 }
 
+func TestDropletCreateUserDataVars(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+		dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "dev0", Size: "1gb", Image: godo.DropletCreateImage{ID: 0, Slug: "image"}, SSHKeys: []godo.DropletCreateSSHKey{}, UserData: "#cloud-config\nhostname: web-1\n"}
+		tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgUserData, "#cloud-config\nhostname: {{.hostname}}\n")
+		config.Doit.Set(config.NS, doctl.ArgUserDataVars, []string{"hostname=web-1"})
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func Test_renderUserData(t *testing.T) {
+	out, err := renderUserData("{{.a}} {{.b}}", []string{"a=1", "b=x=y"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1 x=y", out)
+
+	_, err = renderUserData("{{.missing}}", []string{"a=1"})
+	assert.Error(t, err)
+
+	_, err = renderUserData("{{.a}}", []string{"a"})
+	assert.EqualError(t, err, `user data variable "a" must be in the form key=value`)
+
+	_, err = renderUserData("{{.a", []string{"a=1"})
+	assert.Error(t, err)
+}
+
+func Test_dropletCreateNames(t *testing.T) {
+	cases := []struct {
+		name     string
+		args     []string
+		count    int
+		template string
+		expected []string
+		err      string
+	}{
+		{name: "positional", args: []string{"a", "b"}, expected: []string{"a", "b"}},
+		{name: "count", args: []string{"web"}, count: 3, expected: []string{"web-1", "web-2", "web-3"}},
+		{name: "template", count: 2, template: "web-{{.Index}}-{{.Region}}", expected: []string{"web-1-nyc3", "web-2-nyc3"}},
+		{name: "template with name", args: []string{"api"}, count: 2, template: "{{.Name}}{{.Index}}", expected: []string{"api1", "api2"}},
+		{name: "collision", count: 2, template: "web-{{.Region}}", err: `name template renders "web-nyc3" for both droplet 1 and 2`},
+		{name: "empty name", count: 1, template: "{{.Name}}", err: "name template renders an empty name for droplet 1"},
+		{name: "unknown field", count: 1, template: "{{.Size}}", err: "unable to render name template"},
+		{name: "template without count", args: []string{"web"}, template: "{{.Index}}", err: "--name-template requires --count"},
+		{name: "count with names", args: []string{"a", "b"}, count: 2, err: "--count takes a single droplet name"},
+		{name: "count without name", count: 2, err: "(test) command is missing required arguments"},
+	}
+
+	for _, tc := range cases {
+		names, err := dropletCreateNames("test", tc.args, tc.count, tc.template, "nyc3")
+		if tc.err != "" {
+			if assert.Error(t, err, tc.name) {
+				assert.Contains(t, err.Error(), tc.err, tc.name)
+			}
+			continue
+		}
+
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, tc.expected, names, tc.name)
+	}
+}
+
+func TestDropletCreateWait(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+		dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "dev0", Size: "1gb", Image: godo.DropletCreateImage{ID: 0, Slug: "image"}, SSHKeys: []godo.DropletCreateSSHKey{}}
+		tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
+
+		createAction := do.Action{Action: &godo.Action{ID: 2, Type: "create", Status: godo.ActionCompleted}}
+		tm.droplets.On("Actions", testDroplet.ID, allPages).Return(do.Actions{createAction}, nil)
+		tm.actions.On("Get", 2).Return(&createAction, nil)
+		tm.droplets.On("Get", testDroplet.ID).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+		config.Doit.Set(config.NS, doctl.ArgWaitTimeout, 300)
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletCreateWaitTimeout(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+		dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "dev0", Size: "1gb", Image: godo.DropletCreateImage{ID: 0, Slug: "image"}, SSHKeys: []godo.DropletCreateSSHKey{}}
+		tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
+
+		createAction := do.Action{Action: &godo.Action{ID: 2, Type: "create", Status: godo.ActionInProgress}}
+		tm.droplets.On("Actions", testDroplet.ID, allPages).Return(do.Actions{createAction}, nil)
+		tm.actions.On("Get", 2).Return(&createAction, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+		config.Doit.Set(config.NS, doctl.ArgWaitTimeout, 1)
+
+		err := RunDropletCreate(config)
+		assert.Error(t, err)
+	})
+}
+
+func TestDropletCreateWaitTimeoutTagsAndShowsDroplet(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+		dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "dev0", Size: "1gb", Image: godo.DropletCreateImage{ID: 0, Slug: "image"}, SSHKeys: []godo.DropletCreateSSHKey{}}
+		tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
+
+		trr := &godo.TagResourcesRequest{
+			Resources: []godo.Resource{
+				{ID: "1", Type: godo.DropletResourceType},
+			},
+		}
+		tm.tags.On("Create", &godo.TagCreateRequest{Name: "my-tag"}).Return(&testTag, nil)
+		tm.tags.On("TagResources", "my-tag", trr).Return(nil)
+
+		createAction := do.Action{Action: &godo.Action{ID: 2, Type: "create", Status: godo.ActionInProgress}}
+		tm.droplets.On("Actions", testDroplet.ID, allPages).Return(do.Actions{createAction}, nil)
+		tm.actions.On("Get", 2).Return(&createAction, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgTagName, "my-tag")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+		config.Doit.Set(config.NS, doctl.ArgWaitTimeout, 1)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,Name")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletCreate(config)
+		assert.EqualError(t, err, "droplet 1 created but not active after 1s")
+		assert.Equal(t, "1\ta-droplet\n", buf.String())
+	})
+}
+
+func TestDropletCreateFromFile(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "ubuntu-16-04-x64").Return(&testImage, nil)
+		web := &godo.DropletCreateRequest{Name: "web-1", Region: "nyc3", Size: "512mb", Image: godo.DropletCreateImage{Slug: "ubuntu-16-04-x64"}, SSHKeys: []godo.DropletCreateSSHKey{}}
+		dbVolume := do.Volume{Volume: &godo.Volume{ID: uuid.New(), Name: "db-volume", Region: &godo.Region{Slug: "nyc3"}}}
+		tm.volumes.On("List", allPages).Return([]do.Volume{dbVolume}, nil)
+		db := &godo.DropletCreateRequest{Name: "db-1", Region: "nyc3", Size: "1gb", Image: godo.DropletCreateImage{ID: 12345}, SSHKeys: []godo.DropletCreateSSHKey{}, UserData: "#cloud-config", Volumes: []godo.DropletCreateVolume{{ID: dbVolume.ID}}}
+		tm.droplets.On("Create", web).Return(&testDroplet, nil)
+		tm.droplets.On("Create", db).Return(&anotherTestDroplet, nil)
+
+		trr := &godo.TagResourcesRequest{
+			Resources: []godo.Resource{
+				{ID: "1", Type: godo.DropletResourceType},
+			},
+		}
+		tm.tags.On("Create", &godo.TagCreateRequest{Name: "web"}).Return(&testTag, nil)
+		tm.tags.On("TagResources", "web", trr).Return(nil)
+
+		config.Doit.Set(config.NS, doctl.ArgDropletFromFile, "../testdata/droplets.yml")
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletCreateFromFileDryRun(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		var buf bytes.Buffer
+		config.Out = &buf
+
+		config.Doit.Set(config.NS, doctl.ArgDropletFromFile, "../testdata/droplets.yml")
+		config.Doit.Set(config.NS, doctl.ArgDryRun, true)
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), `"name": "web-1"`)
+		assert.Contains(t, buf.String(), `"name": "db-1"`)
+	})
+}
+
+func Test_parseDropletSpecs(t *testing.T) {
+	specs, err := parseDropletSpecs([]byte(`[{"name": "web-1", "region": "nyc3", "size": "512mb", "image": "ubuntu-16-04-x64"}]`))
+	assert.NoError(t, err)
+	assert.Equal(t, []dropletSpec{{Name: "web-1", Region: "nyc3", Size: "512mb", Image: "ubuntu-16-04-x64"}}, specs)
+
+	_, err = parseDropletSpecs([]byte("- name: web-1\n  region: nyc3\n  size: 512mb\n  image: ubuntu\n  flavour: large\n"))
+	assert.EqualError(t, err, `droplet spec 1: unknown field "flavour"`)
+
+	_, err = parseDropletSpecs([]byte("- name: web-1\n  region: nyc3\n"))
+	assert.EqualError(t, err, "droplet spec 1: missing required field(s) size, image")
+}
+
 func TestDropletDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.droplets.On("Delete", 1).Return(nil)
@@ -154,6 +673,22 @@ func TestDropletDelete(t *testing.T) {
 	})
 }
 
+func TestDropletDeleteMultiple_PartialFailure(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.On("Delete", 1).Return(nil)
+		tm.droplets.On("Delete", 2).Return(fmt.Errorf("not found"))
+		tm.droplets.On("Delete", 3).Return(nil)
+
+		config.Args = append(config.Args, "1", "2", "3")
+		config.Doit.Set(config.NS, doctl.ArgDeleteForce, true)
+		config.Doit.Set(config.NS, doctl.ArgMaxConcurrency, 2)
+
+		err := RunDropletDelete(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "droplet 2: not found")
+	})
+}
+
 func TestDropletDeleteByTag(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.droplets.On("DeleteByTag", "my-tag").Return(nil)
@@ -182,7 +717,7 @@ func TestDropletDeleteRepeatedID(t *testing.T) {
 
 func TestDropletDeleteByName(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.On("List").Return(testDropletList, nil)
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
 		tm.droplets.On("Delete", 1).Return(nil)
 
 		config.Args = append(config.Args, testDroplet.Name)
@@ -196,7 +731,7 @@ func TestDropletDeleteByName(t *testing.T) {
 func TestDropletDeleteByName_Ambiguous(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		list := do.Droplets{testDroplet, testDroplet}
-		tm.droplets.On("List").Return(list, nil)
+		tm.droplets.On("List", allPages).Return(list, nil)
 
 		config.Args = append(config.Args, testDroplet.Name)
 		config.Doit.Set(config.NS, doctl.ArgDeleteForce, true)
@@ -209,7 +744,7 @@ func TestDropletDeleteByName_Ambiguous(t *testing.T) {
 
 func TestDropletDelete_MixedNameAndType(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.On("List").Return(testDropletList, nil)
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
 		tm.droplets.On("Delete", 1).Return(nil).Once()
 
 		id := strconv.Itoa(testDroplet.ID)
@@ -247,7 +782,7 @@ func TestDropletGet_Template(t *testing.T) {
 
 func TestDropletKernelList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.On("Kernels", testDroplet.ID).Return(testKernelList, nil)
+		tm.droplets.On("Kernels", testDroplet.ID, allPages).Return(testKernelList, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -267,9 +802,38 @@ func TestDropletNeighbors(t *testing.T) {
 	})
 }
 
+func TestDropletNeighborsAll(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.On("AllNeighbors").Return([]do.Droplets{
+			{testDroplet, anotherTestDroplet},
+			{testDroplet},
+		}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgNeighborsAll, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Group,ID")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletNeighbors(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "1\t1\n1\t3\n2\t1\n", buf.String())
+	})
+}
+
+func TestDropletNeighborsAllWithID(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgNeighborsAll, true)
+
+		err := RunDropletNeighbors(config)
+		assert.EqualError(t, err, "a droplet id can't be combined with --all")
+	})
+}
+
 func TestDropletSnapshotList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.On("Snapshots", testDroplet.ID).Return(testImageList, nil)
+		tm.droplets.On("Snapshots", testDroplet.ID, allPages).Return(testImageList, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -280,16 +844,49 @@ func TestDropletSnapshotList(t *testing.T) {
 
 func TestDropletsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.On("List").Return(testDropletList, nil)
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
 
 		err := RunDropletList(config)
 		assert.NoError(t, err)
 	})
 }
 
+func TestDropletsListPage(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.On("List", &godo.ListOptions{Page: 2, PerPage: 20}).Return(testDropletList, nil)
+
+		config.Doit.Set(doctl.NSRoot, "page", 2)
+		config.Doit.Set(doctl.NSRoot, "per-page", 20)
+
+		err := RunDropletList(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletCreateLookupsIgnorePage(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+		tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+		tm.keys.On("List", allPages).Return(do.SSHKeys{{Key: &godo.Key{ID: 10, Name: "laptop"}}}, nil)
+		dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "dev0", Size: "1gb", Image: godo.DropletCreateImage{Slug: "image"},
+			SSHKeys: []godo.DropletCreateSSHKey{{ID: 10}}}
+		tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+		config.Doit.Set(doctl.NSRoot, "page", 2)
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgSSHKeys, []string{"laptop"})
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
 func TestDropletsListByTag(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.On("ListByTag", "my-tag").Return(testDropletList, nil)
+		tm.droplets.On("ListByTag", "my-tag", allPages).Return(testDropletList, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgTagName, "my-tag")
 
@@ -298,6 +895,72 @@ func TestDropletsListByTag(t *testing.T) {
 	})
 }
 
+func TestDropletsListFilters(t *testing.T) {
+	newDroplet := func(id int, region, slug string, imageID int) do.Droplet {
+		return do.Droplet{Droplet: &godo.Droplet{
+			ID:     id,
+			Name:   fmt.Sprintf("droplet-%d", id),
+			Region: &godo.Region{Slug: region},
+			Image:  &godo.Image{ID: imageID, Slug: slug},
+		}}
+	}
+	list := do.Droplets{
+		newDroplet(1, "nyc1", "ubuntu-16-04-x64", 100),
+		newDroplet(2, "nyc1", "", 200),
+		newDroplet(3, "sfo2", "ubuntu-16-04-x64", 100),
+		newDroplet(4, "sfo2", "", 200),
+	}
+
+	cases := []struct {
+		region, image string
+		expected      string
+	}{
+		{region: "sfo2", expected: "3\n4\n"},
+		{image: "ubuntu-16-04-x64", expected: "1\n3\n"},
+		{image: "200", expected: "2\n4\n"},
+		{region: "nyc1", image: "200", expected: "2\n"},
+		{region: "lon1", expected: ""},
+	}
+
+	for _, tc := range cases {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.droplets.On("List", allPages).Return(list, nil)
+
+			var buf bytes.Buffer
+			config.Out = &buf
+			config.Doit.Set(config.NS, doctl.ArgRegionSlug, tc.region)
+			config.Doit.Set(config.NS, doctl.ArgImage, tc.image)
+			config.Doit.Set(config.NS, doctl.ArgFormat, "ID")
+			config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+			err := RunDropletList(config)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String(), "region %q image %q", tc.region, tc.image)
+		})
+	}
+}
+
+func TestDropletsListByTagError(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.On("ListByTag", "my-tag", allPages).Return(nil, fmt.Errorf("tag not found"))
+
+		config.Doit.Set(config.NS, doctl.ArgTagName, "my-tag")
+
+		err := RunDropletList(config)
+		assert.EqualError(t, err, "tag not found")
+	})
+}
+
+func TestDropletsListByTagWithIDs(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgTagName, "my-tag")
+
+		err := RunDropletList(config)
+		assert.EqualError(t, err, "droplet ids can't be combined with --tag-name")
+	})
+}
+
 func TestDropletsTag(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		trr := &godo.TagResourcesRequest{
@@ -342,7 +1005,7 @@ func TestDropletsTagByName(t *testing.T) {
 			},
 		}
 		tm.tags.On("TagResources", "my-tag", trr).Return(nil)
-		tm.droplets.On("List").Return(testDropletList, nil)
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
 
 		config.Args = append(config.Args, testDroplet.Name)
 		config.Doit.Set(config.NS, doctl.ArgTagName, "my-tag")
@@ -361,7 +1024,7 @@ func TestDropletsTagMultipleNameAndID(t *testing.T) {
 			},
 		}
 		tm.tags.On("TagResources", "my-tag", trr).Return(nil)
-		tm.droplets.On("List").Return(testDropletList, nil)
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
 
 		config.Args = append(config.Args, testDroplet.Name)
 		config.Args = append(config.Args, strconv.Itoa(anotherTestDroplet.ID))
@@ -381,7 +1044,7 @@ func TestDropletsUntag(t *testing.T) {
 		}
 
 		tm.tags.On("UntagResources", "my-tag", urr).Return(nil)
-		tm.droplets.On("List").Return(testDropletList, nil)
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
 
 		config.Args = []string{testDroplet.Name}
 		config.Doit.Set(config.NS, doctl.ArgTagName, "my-tag")
@@ -419,3 +1082,105 @@ func Test_extractSSHKey(t *testing.T) {
 		assert.Equal(t, c.expected, got)
 	}
 }
+
+func withRegionLatency(t *testing.T, latencies map[string]time.Duration, fn func()) {
+	orig := regionLatency
+	defer func() { regionLatency = orig }()
+
+	regionLatency = func(slug string) (time.Duration, error) {
+		d, ok := latencies[slug]
+		if !ok {
+			return 0, errors.New("unreachable")
+		}
+		return d, nil
+	}
+
+	fn()
+}
+
+var testAutoRegions = do.Regions{
+	{Region: &godo.Region{Slug: "dev0", Available: true}},
+	{Region: &godo.Region{Slug: "nyc3", Available: true}},
+	{Region: &godo.Region{Slug: "sfo1", Available: false}},
+}
+
+func TestDropletCreateAutoRegion(t *testing.T) {
+	latencies := map[string]time.Duration{"dev0": 80 * time.Millisecond, "nyc3": 20 * time.Millisecond}
+	withRegionLatency(t, latencies, func() {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+			tm.regions.On("List", allPages).Return(testAutoRegions, nil)
+			tm.images.On("GetBySlug", "image").Return(&testImage, nil)
+			dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "nyc3", Size: "1gb", Image: godo.DropletCreateImage{Slug: "image"},
+				SSHKeys: []godo.DropletCreateSSHKey{}}
+			tm.droplets.On("Create", dcr).Return(&testDroplet, nil)
+
+			config.Args = append(config.Args, "droplet")
+
+			config.Doit.Set(config.NS, doctl.ArgRegionSlug, "auto")
+			config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+			config.Doit.Set(config.NS, doctl.ArgImage, "image")
+
+			err := RunDropletCreate(config)
+			assert.NoError(t, err)
+		})
+	})
+}
+
+func TestClosestRegion(t *testing.T) {
+	cases := []struct {
+		name      string
+		size      string
+		latencies map[string]time.Duration
+		region    string
+		err       string
+	}{
+		{
+			name:      "only probes regions offering the size",
+			size:      "512mb",
+			latencies: map[string]time.Duration{"dev0": time.Millisecond, "nyc3": time.Second},
+			region:    "nyc3",
+		},
+		{
+			name:      "skips unavailable regions",
+			size:      "1gb",
+			latencies: map[string]time.Duration{"dev0": time.Second, "nyc3": 2 * time.Second, "sfo1": time.Millisecond},
+			region:    "dev0",
+		},
+		{
+			name:      "ignores failed probes",
+			size:      "1gb",
+			latencies: map[string]time.Duration{"nyc3": time.Second},
+			region:    "nyc3",
+		},
+		{
+			name:   "falls back to the first region when probes fail",
+			size:   "1gb",
+			region: "dev0",
+		},
+		{
+			name: "unknown size",
+			size: "64gb",
+			err:  `size "64gb" does not exist`,
+		},
+	}
+
+	for _, c := range cases {
+		withRegionLatency(t, c.latencies, func() {
+			withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+				tm.sizes.On("List", allPages).Return(testAvailableSizes, nil)
+				if c.err == "" {
+					tm.regions.On("List", allPages).Return(testAutoRegions, nil)
+				}
+
+				region, err := closestRegion(config, c.size)
+				if c.err != "" {
+					assert.EqualError(t, err, c.err, c.name)
+					return
+				}
+				assert.NoError(t, err, c.name)
+				assert.Equal(t, c.region, region, c.name)
+			})
+		})
+	}
+}
@@ -60,7 +60,7 @@ func checkErr(err error, cmd ...*cobra.Command) {
 			cmd[0].Help()
 		}
 		fmt.Fprintf(color.Output, "\n%s: %v\n", colorErr, err)
-	case "json":
+	case "json", "json-flat":
 		es := outputErrors{
 			Errors: []outputError{
 				{Detail: err.Error()},
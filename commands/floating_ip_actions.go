@@ -19,7 +19,9 @@ import (
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
 	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
 )
 
 // FloatingIPAction creates the floating IP action commmand.
@@ -37,13 +39,17 @@ func FloatingIPAction() *Command {
 		"get <floating-ip> <action-id>", "get floating-ip action", Writer,
 		displayerType(&action{}), docCategories("floatingip"))
 
-	CmdBuilder(cmd, RunFloatingIPActionsAssign,
+	cmdFloatingIPActionsAssign := CmdBuilder(cmd, RunFloatingIPActionsAssign,
 		"assign <floating-ip> <droplet-id>", "assign a floating IP to a droplet", Writer,
 		displayerType(&action{}), docCategories("floatingip"))
+	AddBoolFlag(cmdFloatingIPActionsAssign, doctl.ArgCommandWait, false, "Wait for the floating IP to be assigned")
+	AddIntFlag(cmdFloatingIPActionsAssign, doctl.ArgWaitTimeout, 300, waitTimeoutUsage)
 
-	CmdBuilder(cmd, RunFloatingIPActionsUnassign,
+	cmdFloatingIPActionsUnassign := CmdBuilder(cmd, RunFloatingIPActionsUnassign,
 		"unassign <floating-ip>", "unassign a floating IP to a droplet", Writer,
 		displayerType(&action{}), docCategories("floatingip"))
+	AddBoolFlag(cmdFloatingIPActionsUnassign, doctl.ArgCommandWait, false, "Wait for the floating IP to be unassigned")
+	AddIntFlag(cmdFloatingIPActionsUnassign, doctl.ArgWaitTimeout, 300, waitTimeoutUsage)
 
 	return cmd
 }
@@ -89,11 +95,10 @@ func RunFloatingIPActionsAssign(c *CmdConfig) error {
 
 	a, err := fia.Assign(ip, dropletID)
 	if err != nil {
-		checkErr(fmt.Errorf("could not assign IP to droplet: %v", err))
+		return fmt.Errorf("could not assign IP to droplet: %v", err)
 	}
 
-	item := &action{actions: do.Actions{*a}}
-	return c.Display(item)
+	return displayFloatingIPAction(c, ip, a)
 }
 
 // RunFloatingIPActionsUnassign unassigns a floating IP to a droplet.
@@ -108,7 +113,34 @@ func RunFloatingIPActionsUnassign(c *CmdConfig) error {
 
 	a, err := fia.Unassign(ip)
 	if err != nil {
-		checkErr(fmt.Errorf("could not unassign IP to droplet: %v", err))
+		return fmt.Errorf("could not unassign IP to droplet: %v", err)
+	}
+
+	return displayFloatingIPAction(c, ip, a)
+}
+
+// displayFloatingIPAction displays a floating IP action, first waiting for
+// it to complete if --wait was given.
+func displayFloatingIPAction(c *CmdConfig, ip string, a *do.Action) error {
+	wait, err := c.Doit.GetBool(c.NS, doctl.ArgCommandWait)
+	if err != nil {
+		return err
+	}
+
+	if wait {
+		waitTimeout, err := c.Doit.GetInt(c.NS, doctl.ArgWaitTimeout)
+		if err != nil {
+			return err
+		}
+
+		a, err = waitForActive(context.Background(), c.Actions(), a.ID, waitTimeoutOrForever(waitTimeout))
+		if err != nil {
+			return fmt.Errorf("floating IP %s: %v", ip, err)
+		}
+
+		if a.Status != godo.ActionCompleted {
+			return fmt.Errorf("floating IP %s action %d finished with status %q", ip, a.ID, a.Status)
+		}
 	}
 
 	item := &action{actions: do.Actions{*a}}
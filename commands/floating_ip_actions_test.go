@@ -16,6 +16,9 @@ package commands
 import (
 	"testing"
 
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -58,3 +61,32 @@ func TestFloatingIPActionsUnassign(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestFloatingIPActionsAssignWait(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		completed := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionCompleted}}
+		tm.floatingIPActions.On("Assign", "127.0.0.1", 2).Return(&testAction, nil)
+		tm.actions.On("Get", 1).Return(&completed, nil)
+
+		config.Args = append(config.Args, "127.0.0.1", "2")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+
+		err := RunFloatingIPActionsAssign(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestFloatingIPActionsUnassignWaitTimeout(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		inProgress := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionInProgress}}
+		tm.floatingIPActions.On("Unassign", "127.0.0.1").Return(&testAction, nil)
+		tm.actions.On("Get", 1).Return(&inProgress, nil)
+
+		config.Args = append(config.Args, "127.0.0.1")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+		config.Doit.Set(config.NS, doctl.ArgWaitTimeout, 1)
+
+		err := RunFloatingIPActionsUnassign(config)
+		assert.Error(t, err)
+	})
+}
@@ -46,9 +46,11 @@ func FloatingIP() *Command {
 			doctl.ArgRegionSlug))
 
 	CmdBuilder(cmd, RunFloatingIPGet, "get <floating-ip>", "get the details of a floating IP", Writer,
-		aliasOpt("g"), displayerType(&floatingIP{}), docCategories("floatingip"))
+		aliasOpt("g"), displayerType(&floatingIP{}), docCategories("floatingip"),
+		completeArgs("floating-ip"))
 
-	CmdBuilder(cmd, RunFloatingIPDelete, "delete <floating-ip>", "delete a floating IP address", Writer, aliasOpt("d"))
+	CmdBuilder(cmd, RunFloatingIPDelete, "delete <floating-ip>", "delete a floating IP address", Writer, aliasOpt("d"),
+		completeArgs("floating-ip"))
 
 	cmdFloatingIPList := CmdBuilder(cmd, RunFloatingIPList, "list", "list all floating IP addresses", Writer,
 		aliasOpt("ls"), displayerType(&floatingIP{}), docCategories("floatingip"))
@@ -61,16 +63,22 @@ func FloatingIP() *Command {
 func RunFloatingIPCreate(c *CmdConfig) error {
 	fis := c.FloatingIPs()
 
-	// ignore errors since we don't know which one is valid
-	region, _ := c.Doit.GetString(c.NS, doctl.ArgRegionSlug)
-	dropletID, _ := c.Doit.GetInt(c.NS, doctl.ArgDropletID)
+	region, err := c.Doit.GetString(c.NS, doctl.ArgRegionSlug)
+	if err != nil {
+		return err
+	}
 
-	if region == "" && dropletID == 0 {
-		return doctl.NewMissingArgsErr("region and droplet id can't both be blank")
+	dropletID, err := c.Doit.GetInt(c.NS, doctl.ArgDropletID)
+	if err != nil {
+		return err
 	}
 
-	if region != "" && dropletID != 0 {
-		return fmt.Errorf("specify region or droplet id when creating a floating ip")
+	// A region creates an unassigned IP, a droplet ID one assigned to it.
+	switch {
+	case region == "" && dropletID == 0:
+		return fmt.Errorf("--%s or --%s is required", doctl.ArgRegionSlug, doctl.ArgDropletID)
+	case region != "" && dropletID != 0:
+		return fmt.Errorf("--%s and --%s are mutually exclusive", doctl.ArgRegionSlug, doctl.ArgDropletID)
 	}
 
 	req := &godo.FloatingIPCreateRequest{
@@ -80,7 +88,6 @@ func RunFloatingIPCreate(c *CmdConfig) error {
 
 	ip, err := fis.Create(req)
 	if err != nil {
-		fmt.Println(err)
 		return err
 	}
 
@@ -133,7 +140,12 @@ func RunFloatingIPList(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := fis.List()
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	list, err := fis.List(opt)
 	if err != nil {
 		return err
 	}
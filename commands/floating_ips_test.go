@@ -14,9 +14,11 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
 )
@@ -29,7 +31,7 @@ func TestFloatingIPCommands(t *testing.T) {
 
 func TestFloatingIPsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.floatingIPs.On("List").Return(testFloatingIPList, nil)
+		tm.floatingIPs.On("List", allPages).Return(testFloatingIPList, nil)
 
 		RunFloatingIPList(config)
 	})
@@ -60,19 +62,25 @@ func TestFloatingIPsCreate_Droplet(t *testing.T) {
 func TestFloatingIPsCreate_Region(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		ficr := &godo.FloatingIPCreateRequest{Region: "dev0"}
-		tm.floatingIPs.On("Create", ficr).Return(&testFloatingIP, nil)
+		unassigned := do.FloatingIP{FloatingIP: &godo.FloatingIP{IP: "192.0.2.10", Region: &godo.Region{Slug: "dev0"}}}
+		tm.floatingIPs.On("Create", ficr).Return(&unassigned, nil)
 
+		var buf bytes.Buffer
+		config.Out = &buf
 		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "IP,Region,DropletID,DropletName")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
 
 		err := RunFloatingIPCreate(config)
 		assert.NoError(t, err)
+		assert.Equal(t, "192.0.2.10\tdev0\t\t\n", buf.String())
 	})
 }
 
 func TestFloatingIPsCreate_fail_with_no_args(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		err := RunFloatingIPCreate(config)
-		assert.Error(t, err)
+		assert.EqualError(t, err, "--region or --droplet-id is required")
 	})
 }
 
@@ -82,7 +90,7 @@ func TestFloatingIPsCreate_fail_with_both_args(t *testing.T) {
 		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
 
 		err := RunFloatingIPCreate(config)
-		assert.Error(t, err)
+		assert.EqualError(t, err, "--region and --droplet-id are mutually exclusive")
 	})
 }
 
@@ -14,15 +14,18 @@ limitations under the License.
 package commands
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/digitalocean/doctl"
 )
 
-func handleColumns(ns string, config doctl.Config) ([]string, error) {
+// handleColumns returns the columns selected with --format and whether the
+// header should be hidden.
+func handleColumns(ns string, config doctl.Config) ([]string, bool, error) {
 	colStr, err := config.GetString(ns, doctl.ArgFormat)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	var cols []string
@@ -32,12 +35,29 @@ func handleColumns(ns string, config doctl.Config) ([]string, error) {
 		}
 	}
 
-	hh, err := config.GetBool(ns, doctl.ArgNoHeader)
+	noHeader, err := config.GetBool(ns, doctl.ArgNoHeader)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	hc.HideHeader(hh)
+	return cols, noHeader, nil
+}
+
+// checkColumns returns an error if a column selected with --format isn't one
+// that item can display. Commands that change resources call it before doing
+// any work so a typo doesn't leave them without output.
+func checkColumns(ns string, config doctl.Config, item Displayable) error {
+	cols, _, err := handleColumns(ns, config)
+	if err != nil {
+		return err
+	}
+
+	colMap := item.ColMap()
+	for _, c := range cols {
+		if _, ok := colMap[c]; !ok {
+			return fmt.Errorf("unknown column %q", c)
+		}
+	}
 
-	return cols, nil
+	return nil
 }
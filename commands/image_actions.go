@@ -21,6 +21,7 @@ import (
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
 )
 
 // ImageAction creates the image action commmand.
@@ -42,7 +43,8 @@ func ImageAction() *Command {
 		"transfer <image-id>", "transfer image", Writer,
 		displayerType(&action{}), docCategories("image"))
 	AddStringFlag(cmdImageActionsTransfer, doctl.ArgRegionSlug, "", "region", requiredOpt())
-	AddBoolFlag(cmdImageActionsTransfer, doctl.ArgCommandWait, false, "Wait for action to complete")
+	AddBoolFlag(cmdImageActionsTransfer, doctl.ArgCommandWait, false, "Wait for the transfer to complete and print the image")
+	AddIntFlag(cmdImageActionsTransfer, doctl.ArgWaitTimeout, 0, waitTimeoutUsage)
 
 	return cmd
 }
@@ -92,13 +94,23 @@ func RunImageActionsTransfer(c *CmdConfig) error {
 		return err
 	}
 
+	img, err := c.Images().GetByID(id)
+	if err != nil {
+		return err
+	}
+	for _, r := range img.Regions {
+		if r == region {
+			return fmt.Errorf("image %d is already available in %s", id, region)
+		}
+	}
+
 	req := &godo.ActionRequest{
 		"region": region,
 	}
 
 	a, err := ias.Transfer(id, req)
 	if err != nil {
-		checkErr(fmt.Errorf("could not transfer image: %v", err))
+		return fmt.Errorf("could not transfer image: %v", err)
 	}
 
 	wait, err := c.Doit.GetBool(c.NS, doctl.ArgCommandWait)
@@ -106,14 +118,28 @@ func RunImageActionsTransfer(c *CmdConfig) error {
 		return err
 	}
 
-	if wait {
-		a, err = actionWait(c, a.ID, 5)
-		if err != nil {
-			return err
-		}
+	if !wait {
+		item := &action{actions: do.Actions{*a}}
+		return c.Display(item)
+	}
 
+	waitTimeout, err := c.Doit.GetInt(c.NS, doctl.ArgWaitTimeout)
+	if err != nil {
+		return err
 	}
 
-	item := &action{actions: do.Actions{*a}}
-	return c.Display(item)
+	a, err = waitForActive(context.Background(), c.Actions(), a.ID, waitTimeoutOrForever(waitTimeout))
+	if err != nil {
+		return err
+	}
+	if a.Status != godo.ActionCompleted {
+		return fmt.Errorf("transfer action %d %s", a.ID, a.Status)
+	}
+
+	img, err = c.Images().GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	return c.Display(&image{images: do.Images{*img}})
 }
@@ -14,9 +14,11 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
 )
@@ -44,6 +46,7 @@ func TestImageActionsGet(t *testing.T) {
 func TestImageActionsTransfer(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		ar := &godo.ActionRequest{"region": "dev0"}
+		tm.images.On("GetByID", 1).Return(&testImage, nil)
 		tm.imageActions.On("Transfer", 1, ar).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
@@ -54,3 +57,39 @@ func TestImageActionsTransfer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestImageActionsTransferWait(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		ar := &godo.ActionRequest{"region": "dev0"}
+		completed := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionCompleted}}
+		transferred := do.Image{Image: &godo.Image{ID: 1, Slug: "slug", Regions: []string{"test0", "dev0"}}}
+		tm.images.On("GetByID", 1).Return(&testImage, nil).Once()
+		tm.images.On("GetByID", 1).Return(&transferred, nil).Once()
+		tm.imageActions.On("Transfer", 1, ar).Return(&testAction, nil)
+		tm.actions.On("Get", 1).Return(&completed, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,Slug")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunImageActionsTransfer(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "1\tslug\n", buf.String())
+	})
+}
+
+func TestImageActionsTransferSameRegion(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.images.On("GetByID", 1).Return(&testImage, nil)
+
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "test0")
+
+		err := RunImageActionsTransfer(config)
+		assert.EqualError(t, err, "image 1 is already available in test0")
+	})
+}
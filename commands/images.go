@@ -16,6 +16,7 @@ package commands
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
@@ -38,6 +39,8 @@ func Images() *Command {
 	cmdImagesList := CmdBuilder(cmd, RunImagesList, "list", "list images", Writer,
 		aliasOpt("ls"), displayerType(&image{}), docCategories("image"))
 	AddBoolFlag(cmdImagesList, doctl.ArgImagePublic, false, "List public images")
+	AddStringFlag(cmdImagesList, doctl.ArgImageType, "", "Image type [application|distribution]")
+	AddStringFlag(cmdImagesList, doctl.ArgImageDistribution, "", "Image distribution, e.g. Ubuntu")
 
 	cmdImagesListDistribution := CmdBuilder(cmd, RunImagesListDistribution,
 		"list-distribution", "list distribution images", Writer,
@@ -68,7 +71,8 @@ func Images() *Command {
 	return cmd
 }
 
-// RunImagesList images.
+// RunImagesList images. Private images are listed unless --public or
+// --type is given.
 func RunImagesList(c *CmdConfig) error {
 	is := c.Images()
 
@@ -77,11 +81,50 @@ func RunImagesList(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := is.List(public)
+	imageType, err := c.Doit.GetString(c.NS, doctl.ArgImageType)
 	if err != nil {
 		return err
 	}
 
+	distribution, err := c.Doit.GetString(c.NS, doctl.ArgImageDistribution)
+	if err != nil {
+		return err
+	}
+
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	var list do.Images
+	switch imageType {
+	case "":
+		if public {
+			list, err = is.List(public, opt)
+		} else {
+			list, err = is.ListUser(public, opt)
+		}
+	case "application":
+		list, err = is.ListApplication(public, opt)
+	case "distribution":
+		list, err = is.ListDistribution(public, opt)
+	default:
+		return fmt.Errorf("unknown image type %q, must be application or distribution", imageType)
+	}
+	if err != nil {
+		return err
+	}
+
+	if distribution != "" {
+		var filtered do.Images
+		for _, i := range list {
+			if strings.EqualFold(i.Distribution, distribution) {
+				filtered = append(filtered, i)
+			}
+		}
+		list = filtered
+	}
+
 	item := &image{images: list}
 	return c.Display(item)
 }
@@ -95,7 +138,12 @@ func RunImagesListDistribution(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := is.ListDistribution(public)
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	list, err := is.ListDistribution(public, opt)
 	if err != nil {
 		return err
 	}
@@ -114,7 +162,12 @@ func RunImagesListApplication(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := is.ListApplication(public)
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	list, err := is.ListApplication(public, opt)
 	if err != nil {
 		return err
 	}
@@ -132,7 +185,12 @@ func RunImagesListUser(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := is.ListUser(public)
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	list, err := is.ListUser(public, opt)
 	if err != nil {
 		return err
 	}
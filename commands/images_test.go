@@ -14,10 +14,13 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
 )
@@ -30,16 +33,76 @@ func TestImageCommand(t *testing.T) {
 
 func TestImagesList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.On("List", false).Return(testImageList, nil)
+		tm.images.On("ListUser", false, allPages).Return(testImageList, nil)
 
 		err := RunImagesList(config)
 		assert.NoError(t, err)
 	})
 }
 
+func TestImagesListPublic(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.images.On("List", true, allPages).Return(testImageList, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgImagePublic, true)
+
+		err := RunImagesList(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestImagesListTypeAndDistribution(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		ubuntu := do.Image{Image: &godo.Image{ID: 3, Distribution: "Ubuntu"}}
+		coreos := do.Image{Image: &godo.Image{ID: 4, Distribution: "CoreOS"}}
+		tm.images.On("ListDistribution", true, allPages).Return(do.Images{ubuntu, coreos}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgImagePublic, true)
+		config.Doit.Set(config.NS, doctl.ArgImageType, "distribution")
+		config.Doit.Set(config.NS, doctl.ArgImageDistribution, "ubuntu")
+
+		err := RunImagesList(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Ubuntu")
+		assert.NotContains(t, buf.String(), "CoreOS")
+	})
+}
+
+func TestImagesListPublicAndMinDiskColumns(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		public := do.Image{Image: &godo.Image{ID: 5, Name: "ubuntu", Type: "snapshot", Public: true, MinDiskSize: 20}}
+		custom := do.Image{Image: &godo.Image{ID: 6, Name: "web-base", Type: "snapshot", MinDiskSize: 50}}
+		tm.images.On("List", true, allPages).Return(do.Images{public, custom}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgImagePublic, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,Name,Public,MinDisk")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunImagesList(config)
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Len(t, lines, 2)
+		assert.Equal(t, []string{"5", "ubuntu", "true", "20"}, strings.Fields(lines[0]))
+		assert.Equal(t, []string{"6", "web-base", "false", "50"}, strings.Fields(lines[1]))
+	})
+}
+
+func TestImagesListUnknownType(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgImageType, "snapshot")
+
+		err := RunImagesList(config)
+		assert.Error(t, err)
+	})
+}
+
 func TestImagesListDistribution(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.On("ListDistribution", false).Return(testImageList, nil)
+		tm.images.On("ListDistribution", false, allPages).Return(testImageList, nil)
 
 		err := RunImagesListDistribution(config)
 		assert.NoError(t, err)
@@ -48,7 +111,7 @@ func TestImagesListDistribution(t *testing.T) {
 
 func TestImagesListApplication(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.On("ListApplication", false).Return(testImageList, nil)
+		tm.images.On("ListApplication", false, allPages).Return(testImageList, nil)
 
 		err := RunImagesListApplication(config)
 		assert.NoError(t, err)
@@ -57,7 +120,7 @@ func TestImagesListApplication(t *testing.T) {
 
 func TestImagesListUser(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.On("ListUser", false).Return(testImageList, nil)
+		tm.images.On("ListUser", false, allPages).Return(testImageList, nil)
 
 		err := RunImagesListUser(config)
 		assert.NoError(t, err)
@@ -19,14 +19,11 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/digitalocean/doctl/do"
 )
 
-var (
-	hc = &headerControl{}
-)
-
 func newTabWriter(out io.Writer) *tabwriter.Writer {
 	w := new(tabwriter.Writer)
 	w.Init(out, 0, 8, 1, '\t', 0)
@@ -34,14 +31,6 @@ func newTabWriter(out io.Writer) *tabwriter.Writer {
 	return w
 }
 
-type headerControl struct {
-	hideHeader bool
-}
-
-func (hc *headerControl) HideHeader(hide bool) {
-	hc.hideHeader = hide
-}
-
 type rateLimit struct {
 	*do.RateLimit
 }
@@ -52,6 +41,14 @@ func (rl *rateLimit) JSON(out io.Writer) error {
 	return writeJSON(rl.Rate, out)
 }
 
+func (rl *rateLimit) YAML(out io.Writer) error {
+	return writeYAML(rl.Rate, out)
+}
+
+func (rl *rateLimit) Raw() interface{} {
+	return rl.Rate
+}
+
 func (rl *rateLimit) Cols() []string {
 	return []string{
 		"Limit", "Remaining", "Reset",
@@ -84,23 +81,31 @@ func (a *account) JSON(out io.Writer) error {
 	return writeJSON(a.Account, out)
 }
 
+func (a *account) YAML(out io.Writer) error {
+	return writeYAML(a.Account, out)
+}
+
+func (a *account) Raw() interface{} {
+	return a.Account
+}
+
 func (a *account) Cols() []string {
 	return []string{
-		"Email", "DropletLimit", "EmailVerified", "UUID", "Status",
+		"Email", "DropletLimit", "FloatingIPLimit", "EmailVerified", "UUID", "Status",
 	}
 }
 
 func (a *account) ColMap() map[string]string {
 	return map[string]string{
-		"Email": "Email", "DropletLimit": "Droplet Limit", "EmailVerified": "Email Verified",
-		"UUID": "UUID", "Status": "Status",
+		"Email": "Email", "DropletLimit": "Droplet Limit", "FloatingIPLimit": "Floating IP Limit",
+		"EmailVerified": "Email Verified", "UUID": "UUID", "Status": "Status",
 	}
 }
 
 func (a *account) KV() []map[string]interface{} {
 	out := []map[string]interface{}{}
 	x := map[string]interface{}{
-		"Email": a.Email, "DropletLimit": a.DropletLimit,
+		"Email": a.Email, "DropletLimit": a.DropletLimit, "FloatingIPLimit": a.FloatingIPLimit,
 		"EmailVerified": a.EmailVerified, "UUID": a.UUID,
 		"Status": a.Status,
 	}
@@ -119,6 +124,14 @@ func (a *action) JSON(out io.Writer) error {
 	return writeJSON(a.actions, out)
 }
 
+func (a *action) YAML(out io.Writer) error {
+	return writeYAML(a.actions, out)
+}
+
+func (a *action) Raw() interface{} {
+	return a.actions
+}
+
 func (a *action) Cols() []string {
 	return []string{
 		"ID", "Status", "Type", "StartedAt", "CompletedAt", "ResourceID", "ResourceType", "Region",
@@ -163,6 +176,14 @@ func (d *domain) JSON(out io.Writer) error {
 	return writeJSON(d.domains, out)
 }
 
+func (d *domain) YAML(out io.Writer) error {
+	return writeYAML(d.domains, out)
+}
+
+func (d *domain) Raw() interface{} {
+	return d.domains
+}
+
 func (d *domain) Cols() []string {
 	return []string{"Domain", "TTL"}
 }
@@ -194,6 +215,14 @@ func (dr *domainRecord) JSON(out io.Writer) error {
 	return writeJSON(dr.domainRecords, out)
 }
 
+func (dr *domainRecord) YAML(out io.Writer) error {
+	return writeYAML(dr.domainRecords, out)
+}
+
+func (dr *domainRecord) Raw() interface{} {
+	return dr.domainRecords
+}
+
 func (dr *domainRecord) Cols() []string {
 	return []string{
 		"ID", "Type", "Name", "Data", "Priority", "Port", "Weight",
@@ -232,10 +261,24 @@ func (d *droplet) JSON(out io.Writer) error {
 	return writeJSON(d.droplets, out)
 }
 
+func (d *droplet) YAML(out io.Writer) error {
+	return writeYAML(d.droplets, out)
+}
+
+func (d *droplet) Raw() interface{} {
+	return d.droplets
+}
+
 func (d *droplet) Cols() []string {
-	cols := []string{
-		"ID", "Name", "PublicIPv4", "PublicIPv6", "Memory", "VCPUs", "Disk", "Region", "Image", "Status", "Tags",
+	cols := []string{"ID", "Name", "PublicIPv4"}
+	// only show the private address column when a droplet has one.
+	for _, dr := range d.droplets {
+		if ip, _ := dr.PrivateIPv4(); ip != "" {
+			cols = append(cols, "PrivateIPv4")
+			break
+		}
 	}
+	cols = append(cols, "PublicIPv6", "Memory", "VCPUs", "Disk", "Region", "Image", "Status", "Tags")
 	if isBeta() {
 		cols = append(cols, "Volumes")
 	}
@@ -244,10 +287,10 @@ func (d *droplet) Cols() []string {
 
 func (d *droplet) ColMap() map[string]string {
 	return map[string]string{
-		"ID": "ID", "Name": "Name", "PublicIPv4": "Public IPv4", "PublicIPv6": "Public IPv6",
-		"Memory": "Memory", "VCPUs": "VCPUs", "Disk": "Disk",
+		"ID": "ID", "Name": "Name", "PublicIPv4": "Public IPv4", "PrivateIPv4": "Private IPv4",
+		"PublicIPv6": "Public IPv6", "Memory": "Memory", "VCPUs": "VCPUs", "Disk": "Disk",
 		"Region": "Region", "Image": "Image", "Status": "Status",
-		"Tags": "Tags", "Volumes": "Volumes",
+		"Tags": "Tags", "Volumes": "Volumes", "Created": "Created At", "Age": "Age",
 	}
 }
 
@@ -257,13 +300,14 @@ func (d *droplet) KV() []map[string]interface{} {
 		tags := strings.Join(d.Tags, ",")
 		image := fmt.Sprintf("%s %s", d.Image.Distribution, d.Image.Name)
 		ip, _ := d.PublicIPv4()
+		privIP, _ := d.PrivateIPv4()
 		ip6, _ := d.PublicIPv6()
 		volumes := strings.Join(d.VolumeIDs, ",")
 		m := map[string]interface{}{
-			"ID": d.ID, "Name": d.Name, "PublicIPv4": ip, "PublicIPv6": ip6,
+			"ID": d.ID, "Name": d.Name, "PublicIPv4": ip, "PrivateIPv4": privIP, "PublicIPv6": ip6,
 			"Memory": d.Memory, "VCPUs": d.Vcpus, "Disk": d.Disk,
 			"Region": d.Region.Slug, "Image": image, "Status": d.Status,
-			"Tags": tags, "Volumes": volumes,
+			"Tags": tags, "Volumes": volumes, "Created": d.Created, "Age": dropletAge(d.Created, time.Now()),
 		}
 		out = append(out, m)
 	}
@@ -271,6 +315,63 @@ func (d *droplet) KV() []map[string]interface{} {
 	return out
 }
 
+// FlatJSON replaces the nested networks, region, image, size and kernel
+// objects with public_ipv4, private_ipv4, public_ipv6, region_slug, image_id,
+// image_slug and kernel_id.
+func (d *droplet) FlatJSON() ([]map[string]interface{}, error) {
+	out := []map[string]interface{}{}
+	for _, d := range d.droplets {
+		ip, _ := d.PublicIPv4()
+		privIP, _ := d.PrivateIPv4()
+		ip6, _ := d.PublicIPv6()
+		derived := map[string]interface{}{
+			"public_ipv4": ip, "private_ipv4": privIP, "public_ipv6": ip6,
+			"region_slug": "", "image_id": 0, "image_slug": "", "kernel_id": 0,
+		}
+		if d.Region != nil {
+			derived["region_slug"] = d.Region.Slug
+		}
+		if d.Image != nil {
+			derived["image_id"] = d.Image.ID
+			derived["image_slug"] = d.Image.Slug
+		}
+		if d.Kernel != nil {
+			derived["kernel_id"] = d.Kernel.ID
+		}
+
+		m, err := flattenJSON(d, []string{"networks", "region", "image", "size", "kernel"}, derived)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+
+	return out, nil
+}
+
+// dropletAge formats the time since created compactly using its largest
+// unit, e.g. 12d or 3h. It is empty if created can't be parsed.
+func dropletAge(created string, now time.Time) string {
+	t, err := time.Parse(time.RFC3339, created)
+	if err != nil {
+		return ""
+	}
+
+	age := now.Sub(t)
+	switch {
+	case age < 0:
+		return "0s"
+	case age < time.Minute:
+		return fmt.Sprintf("%ds", int(age.Seconds()))
+	case age < time.Hour:
+		return fmt.Sprintf("%dm", int(age.Minutes()))
+	case age < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(age.Hours()/24))
+	}
+}
+
 type floatingIP struct {
 	floatingIPs do.FloatingIPs
 }
@@ -281,6 +382,14 @@ func (fi *floatingIP) JSON(out io.Writer) error {
 	return writeJSON(fi.floatingIPs, out)
 }
 
+func (fi *floatingIP) YAML(out io.Writer) error {
+	return writeYAML(fi.floatingIPs, out)
+}
+
+func (fi *floatingIP) Raw() interface{} {
+	return fi.floatingIPs
+}
+
 func (fi *floatingIP) Cols() []string {
 	return []string{
 		"IP", "Region", "DropletID", "DropletName",
@@ -297,14 +406,17 @@ func (fi *floatingIP) KV() []map[string]interface{} {
 	out := []map[string]interface{}{}
 
 	for _, f := range fi.floatingIPs {
-		var dropletID, dropletName string
+		var dropletID, dropletName, region string
 		if f.Droplet != nil {
 			dropletID = fmt.Sprintf("%d", f.Droplet.ID)
 			dropletName = f.Droplet.Name
 		}
+		if f.Region != nil {
+			region = f.Region.Slug
+		}
 
 		o := map[string]interface{}{
-			"IP": f.IP, "Region": f.Region.Slug,
+			"IP": f.IP, "Region": region,
 			"DropletID": dropletID, "DropletName": dropletName,
 		}
 
@@ -314,6 +426,32 @@ func (fi *floatingIP) KV() []map[string]interface{} {
 	return out
 }
 
+// FlatJSON replaces the nested region and droplet objects with region_slug,
+// droplet_id and droplet_name.
+func (fi *floatingIP) FlatJSON() ([]map[string]interface{}, error) {
+	out := []map[string]interface{}{}
+	for _, f := range fi.floatingIPs {
+		derived := map[string]interface{}{
+			"region_slug": "", "droplet_id": 0, "droplet_name": "",
+		}
+		if f.Region != nil {
+			derived["region_slug"] = f.Region.Slug
+		}
+		if f.Droplet != nil {
+			derived["droplet_id"] = f.Droplet.ID
+			derived["droplet_name"] = f.Droplet.Name
+		}
+
+		m, err := flattenJSON(f, []string{"region", "droplet"}, derived)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+
+	return out, nil
+}
+
 type image struct {
 	images do.Images
 }
@@ -324,6 +462,14 @@ func (gi *image) JSON(out io.Writer) error {
 	return writeJSON(gi.images, out)
 }
 
+func (gi *image) YAML(out io.Writer) error {
+	return writeYAML(gi.images, out)
+}
+
+func (gi *image) Raw() interface{} {
+	return gi.images
+}
+
 func (gi *image) Cols() []string {
 	return []string{
 		"ID", "Name", "Type", "Distribution", "Slug", "Public", "MinDisk",
@@ -367,6 +513,14 @@ func (ke *kernel) JSON(out io.Writer) error {
 	return writeJSON(ke.kernels, out)
 }
 
+func (ke *kernel) YAML(out io.Writer) error {
+	return writeYAML(ke.kernels, out)
+}
+
+func (ke *kernel) Raw() interface{} {
+	return ke.kernels
+}
+
 func (ke *kernel) Cols() []string {
 	return []string{
 		"ID", "Name", "Version",
@@ -403,6 +557,14 @@ func (ke *key) JSON(out io.Writer) error {
 	return writeJSON(ke.keys, out)
 }
 
+func (ke *key) YAML(out io.Writer) error {
+	return writeYAML(ke.keys, out)
+}
+
+func (ke *key) Raw() interface{} {
+	return ke.keys
+}
+
 func (ke *key) Cols() []string {
 	return []string{
 		"ID", "Name", "FingerPrint",
@@ -439,6 +601,14 @@ func (re *region) JSON(out io.Writer) error {
 	return writeJSON(re.regions, out)
 }
 
+func (re *region) YAML(out io.Writer) error {
+	return writeYAML(re.regions, out)
+}
+
+func (re *region) Raw() interface{} {
+	return re.regions
+}
+
 func (re *region) Cols() []string {
 	return []string{
 		"Slug", "Name", "Available",
@@ -465,6 +635,55 @@ func (re *region) KV() []map[string]interface{} {
 	return out
 }
 
+// dropletNeighbors displays groups of droplets sharing hardware as droplet
+// rows with the group number in front.
+type dropletNeighbors struct {
+	groups []do.Droplets
+}
+
+var _ Displayable = &dropletNeighbors{}
+
+func (dn *dropletNeighbors) JSON(out io.Writer) error {
+	return writeJSON(dn.groups, out)
+}
+
+func (dn *dropletNeighbors) YAML(out io.Writer) error {
+	return writeYAML(dn.groups, out)
+}
+
+func (dn *dropletNeighbors) Raw() interface{} {
+	return dn.groups
+}
+
+func (dn *dropletNeighbors) all() *droplet {
+	d := &droplet{}
+	for _, g := range dn.groups {
+		d.droplets = append(d.droplets, g...)
+	}
+	return d
+}
+
+func (dn *dropletNeighbors) Cols() []string {
+	return append([]string{"Group"}, dn.all().Cols()...)
+}
+
+func (dn *dropletNeighbors) ColMap() map[string]string {
+	m := dn.all().ColMap()
+	m["Group"] = "Group"
+	return m
+}
+
+func (dn *dropletNeighbors) KV() []map[string]interface{} {
+	out := []map[string]interface{}{}
+	for i, g := range dn.groups {
+		for _, m := range (&droplet{droplets: g}).KV() {
+			m["Group"] = i + 1
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
 type size struct {
 	sizes do.Sizes
 }
@@ -475,6 +694,14 @@ func (si *size) JSON(out io.Writer) error {
 	return writeJSON(si.sizes, out)
 }
 
+func (si *size) YAML(out io.Writer) error {
+	return writeYAML(si.sizes, out)
+}
+
+func (si *size) Raw() interface{} {
+	return si.sizes
+}
+
 func (si *size) Cols() []string {
 	return []string{
 		"Slug", "Memory", "VCPUs", "Disk", "PriceMonthly", "PriceHourly",
@@ -515,6 +742,14 @@ func (p *plugin) JSON(out io.Writer) error {
 	return writeJSON(p.plugins, out)
 }
 
+func (p *plugin) YAML(out io.Writer) error {
+	return writeYAML(p.plugins, out)
+}
+
+func (p *plugin) Raw() interface{} {
+	return p.plugins
+}
+
 func (p *plugin) Cols() []string {
 	return []string{
 		"Name",
@@ -545,12 +780,20 @@ type tag struct {
 	tags do.Tags
 }
 
-var _ Displayable = &action{}
+var _ Displayable = &tag{}
 
 func (t *tag) JSON(out io.Writer) error {
 	return writeJSON(t.tags, out)
 }
 
+func (t *tag) YAML(out io.Writer) error {
+	return writeYAML(t.tags, out)
+}
+
+func (t *tag) Raw() interface{} {
+	return t.tags
+}
+
 func (t *tag) Cols() []string {
 	return []string{"Name", "DropletCount"}
 }
@@ -588,16 +831,24 @@ func (a *volume) JSON(out io.Writer) error {
 
 }
 
+func (a *volume) YAML(out io.Writer) error {
+	return writeYAML(a.volumes, out)
+}
+
+func (a *volume) Raw() interface{} {
+	return a.volumes
+}
+
 func (a *volume) Cols() []string {
 	return []string{
-		"ID", "Name", "Size", "Region", "Droplet IDs",
+		"ID", "Name", "Size", "Region", "DropletIDs",
 	}
 
 }
 
 func (a *volume) ColMap() map[string]string {
 	return map[string]string{
-		"ID": "ID", "Name": "Name", "Size": "Size", "Region": "Region", "Droplet IDs": "Droplet IDs",
+		"ID": "ID", "Name": "Name", "Size": "Size", "Region": "Region", "DropletIDs": "Droplet IDs",
 	}
 
 }
@@ -612,13 +863,137 @@ func (a *volume) KV() []map[string]interface{} {
 			"Size":   strconv.FormatInt(volume.SizeGigaBytes, 10) + " GiB",
 			"Region": volume.Region.Slug,
 		}
-		m["Droplet IDs"] = ""
+		m["DropletIDs"] = ""
 		if len(volume.DropletIDs) != 0 {
-			m["Droplet IDs"] = fmt.Sprintf("%v", volume.DropletIDs)
+			m["DropletIDs"] = fmt.Sprintf("%v", volume.DropletIDs)
+		}
+		out = append(out, m)
+
+	}
+	return out
+
+}
+
+// FlatJSON replaces the nested region object with region_slug.
+func (a *volume) FlatJSON() ([]map[string]interface{}, error) {
+	out := []map[string]interface{}{}
+	for _, v := range a.volumes {
+		derived := map[string]interface{}{"region_slug": ""}
+		if v.Region != nil {
+			derived["region_slug"] = v.Region.Slug
+		}
+
+		m, err := flattenJSON(v, []string{"region"}, derived)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+
+	return out, nil
+}
+
+type snapshot struct {
+	snapshots []do.Snapshot
+}
+
+var _ Displayable = &snapshot{}
+
+func (s *snapshot) JSON(out io.Writer) error {
+	return writeJSON(s.snapshots, out)
+}
+
+func (s *snapshot) YAML(out io.Writer) error {
+	return writeYAML(s.snapshots, out)
+}
+
+func (s *snapshot) Raw() interface{} {
+	return s.snapshots
+}
+
+func (s *snapshot) Cols() []string {
+	return []string{
+		"ID", "Name", "VolumeID", "Region", "Size", "Created",
+	}
+}
+
+func (s *snapshot) ColMap() map[string]string {
+	return map[string]string{
+		"ID": "ID", "Name": "Name", "VolumeID": "Volume ID", "Region": "Region",
+		"Size": "Size", "Created": "Created At",
+	}
+}
+
+func (s *snapshot) KV() []map[string]interface{} {
+	out := []map[string]interface{}{}
+	for _, ss := range s.snapshots {
+		m := map[string]interface{}{
+			"ID":       ss.ID,
+			"Name":     ss.Name,
+			"VolumeID": ss.VolumeID,
+			"Region":   "",
+			"Size":     strconv.FormatInt(ss.SizeGigaBytes, 10) + " GiB",
+			"Created":  ss.CreatedAt,
+		}
+		if ss.Region != nil {
+			m["Region"] = ss.Region.Slug
 		}
 		out = append(out, m)
+	}
+	return out
+}
 
+type authContext struct {
+	Name    string `json:"name"`
+	Current bool   `json:"current"`
+	Token   string `json:"token"`
+}
+
+type authContexts struct {
+	contexts []authContext
+}
+
+var _ Displayable = &authContexts{}
+
+// redacted returns the contexts without their tokens for structured output.
+func (ac *authContexts) redacted() []authContext {
+	out := make([]authContext, len(ac.contexts))
+	for i, c := range ac.contexts {
+		c.Token = "[REDACTED]"
+		out[i] = c
 	}
 	return out
+}
+
+func (ac *authContexts) JSON(out io.Writer) error {
+	return writeJSON(ac.redacted(), out)
+}
+
+func (ac *authContexts) YAML(out io.Writer) error {
+	return writeYAML(ac.redacted(), out)
+}
 
+func (ac *authContexts) Raw() interface{} {
+	return ac.redacted()
+}
+
+func (ac *authContexts) Cols() []string {
+	return []string{"Name", "Current", "Token"}
+}
+
+func (ac *authContexts) ColMap() map[string]string {
+	return map[string]string{
+		"Name": "Name", "Current": "Current", "Token": "Token",
+	}
+}
+
+func (ac *authContexts) KV() []map[string]interface{} {
+	out := []map[string]interface{}{}
+	for _, c := range ac.contexts {
+		m := map[string]interface{}{
+			"Name": c.Name, "Current": c.Current, "Token": maskToken(c.Token),
+		}
+		out = append(out, m)
+	}
+	return out
 }
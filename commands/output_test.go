@@ -0,0 +1,296 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteYAML(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeYAML(do.Droplets{testDroplet}, &buf)
+	assert.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "- id: 1\n")
+	assert.Contains(t, out, "name: a-droplet\n")
+	assert.Contains(t, out, "ip_address: 8.8.8.8\n")
+}
+
+func TestWriteYAMLEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeYAML(do.Droplets(nil), &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]\n", buf.String())
+}
+
+func TestDisplayTemplate(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgTemplate, `{{range .}}{{.Name}} {{.Region.Slug}}{{"\n"}}{{end}}`)
+
+		err := config.Display(&droplet{droplets: testDropletList})
+		assert.NoError(t, err)
+		assert.Equal(t, "a-droplet test0\nanother-droplet test0\n", buf.String())
+	})
+}
+
+func TestDisplayTemplateParseError(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgTemplate, "{{.Name")
+
+		err := config.Display(&droplet{droplets: testDropletList})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"{{.Name"`)
+	})
+}
+
+func TestDisplayTemplateWithFormat(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgTemplate, "{{.}}")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID")
+
+		err := config.Display(&droplet{droplets: testDropletList})
+		assert.Error(t, err)
+	})
+}
+
+func TestDropletPrivateIPv4Column(t *testing.T) {
+	publicOnly := do.Droplet{Droplet: &godo.Droplet{
+		ID:       2,
+		Name:     "public-only",
+		Image:    &godo.Image{},
+		Region:   &godo.Region{Slug: "test0"},
+		Networks: &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "8.8.4.4", Type: "public"}}},
+	}}
+
+	d := &droplet{droplets: do.Droplets{publicOnly}}
+	assert.NotContains(t, d.Cols(), "PrivateIPv4")
+
+	d = &droplet{droplets: do.Droplets{publicOnly, testDroplet}}
+	assert.Contains(t, d.Cols(), "PrivateIPv4")
+
+	var buf bytes.Buffer
+	err := displayText(d, &buf, []string{"Name", "PublicIPv4", "PrivateIPv4"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "public-only\t8.8.4.4\t\na-droplet\t8.8.8.8\t172.16.1.2\n", buf.String())
+}
+
+func TestDropletAge(t *testing.T) {
+	now := time.Date(2017, 6, 13, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		created, age string
+	}{
+		{"2017-06-13T11:59:15Z", "45s"},
+		{"2017-06-13T11:30:00Z", "30m"},
+		{"2017-06-13T02:00:00Z", "10h"},
+		{"2017-06-01T11:00:00Z", "12d"},
+		{"2017-06-13T12:00:30Z", "0s"},
+		{"", ""},
+		{"yesterday", ""},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.age, dropletAge(c.created, now), c.created)
+	}
+}
+
+func TestDropletAgeColumn(t *testing.T) {
+	created := time.Now().Add(-12*24*time.Hour - time.Hour).UTC().Format(time.RFC3339)
+	d := do.Droplet{Droplet: &godo.Droplet{
+		ID:      2,
+		Name:    "aged",
+		Image:   &godo.Image{},
+		Region:  &godo.Region{Slug: "test0"},
+		Created: created,
+	}}
+
+	var buf bytes.Buffer
+	err := displayText(&droplet{droplets: do.Droplets{d}}, &buf, []string{"Name", "Created", "Age"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "aged\t"+created+"\t12d\n", buf.String())
+}
+
+func TestDropletFlatJSON(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(doctl.NSRoot, "output", "json-flat")
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		err := config.Display(&droplet{droplets: do.Droplets{testDroplet}})
+		assert.NoError(t, err)
+
+		var items []map[string]interface{}
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &items))
+		assert.Len(t, items, 1)
+
+		d := items[0]
+		assert.Equal(t, float64(1), d["id"])
+		assert.Equal(t, "8.8.8.8", d["public_ipv4"])
+		assert.Equal(t, "172.16.1.2", d["private_ipv4"])
+		assert.Equal(t, "test0", d["region_slug"])
+		assert.Equal(t, float64(1), d["image_id"])
+		for _, k := range []string{"networks", "region", "image"} {
+			assert.NotContains(t, d, k)
+		}
+	})
+}
+
+func TestFloatingIPAndVolumeFlatJSON(t *testing.T) {
+	fips, err := (&floatingIP{floatingIPs: testFloatingIPList}).FlatJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, "test0", fips[0]["region_slug"])
+	assert.Equal(t, 1, fips[0]["droplet_id"])
+	assert.Equal(t, "a-droplet", fips[0]["droplet_name"])
+	assert.Equal(t, "127.0.0.1", fips[0]["ip"])
+	assert.NotContains(t, fips[0], "droplet")
+
+	vols, err := (&volume{volumes: testVolumeList}).FlatJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, "atlantis", vols[0]["region_slug"])
+	assert.Equal(t, "test-volume", vols[0]["name"])
+	assert.NotContains(t, vols[0], "region")
+}
+
+func TestFlatJSONUnsupported(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(doctl.NSRoot, "output", "json-flat")
+
+		err := config.Display(&tag{tags: testTagList})
+		assert.EqualError(t, err, "json-flat output is not supported for this command")
+	})
+}
+
+func TestFloatingIPDropletColumns(t *testing.T) {
+	fips := do.FloatingIPs{
+		{FloatingIP: &godo.FloatingIP{IP: "192.0.2.1", Region: &godo.Region{Slug: "nyc3"}, Droplet: testDroplet.Droplet}},
+		{FloatingIP: &godo.FloatingIP{IP: "192.0.2.2", Region: &godo.Region{Slug: "nyc3"}}},
+		{FloatingIP: &godo.FloatingIP{IP: "192.0.2.3"}},
+	}
+
+	var buf bytes.Buffer
+	err := displayCSV(&floatingIP{floatingIPs: fips}, &buf, []string{"IP", "Region", "DropletID", "DropletName"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "192.0.2.1,nyc3,1,a-droplet\n192.0.2.2,nyc3,,\n192.0.2.3,,,\n", buf.String())
+}
+
+func TestDisplayCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := displayCSV(&volume{volumes: []do.Volume{testVolume}}, &buf, []string{"ID", "Name"}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "ID,Name\n"+testVolume.ID+",test-volume\n", buf.String())
+}
+
+func TestDisplayCSVEscaping(t *testing.T) {
+	d := *testDroplet.Droplet
+	d.Name = `web "one", two`
+
+	var buf bytes.Buffer
+	err := displayCSV(&droplet{droplets: do.Droplets{{Droplet: &d}}}, &buf, []string{"ID", "Name"}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "ID,Name\n1,\"web \"\"one\"\", two\"\n", buf.String())
+}
+
+func TestDisplayHeaderAndFormat(t *testing.T) {
+	items := map[string]Displayable{
+		"rateLimit":    &rateLimit{RateLimit: &do.RateLimit{Rate: &godo.Rate{Limit: 5000}}},
+		"account":      &account{Account: &do.Account{Account: &godo.Account{Email: "user@example.com"}}},
+		"action":       &action{actions: testActionList},
+		"domain":       &domain{domains: testDomainList},
+		"domainRecord": &domainRecord{domainRecords: testRecordList},
+		"droplet":      &droplet{droplets: testDropletList},
+		"floatingIP":   &floatingIP{floatingIPs: testFloatingIPList},
+		"image":        &image{images: testImageList},
+		"kernel":       &kernel{kernels: testKernelList},
+		"key":          &key{keys: testKeyList},
+		"region":       &region{regions: testRegionList},
+		"size":         &size{sizes: testSizeList},
+		"plugin":       &plugin{plugins: []plugDesc{{Path: "/bin/doctl-plugin", Name: "plugin"}}},
+		"tag":          &tag{tags: testTagList},
+		"volume":       &volume{volumes: testVolumeList},
+	}
+
+	for name, item := range items {
+		for _, format := range []bool{false, true} {
+			for _, noHeader := range []bool{false, true} {
+				withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+					col := item.Cols()[0]
+					if format {
+						col = item.Cols()[len(item.Cols())-1]
+						config.Doit.Set(config.NS, doctl.ArgFormat, col)
+					}
+					config.Doit.Set(config.NS, doctl.ArgNoHeader, noHeader)
+
+					var buf bytes.Buffer
+					config.Out = &buf
+
+					err := config.Display(item)
+					assert.NoError(t, err)
+
+					lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+					hasHeader := strings.HasPrefix(lines[0], item.ColMap()[col])
+					desc := fmt.Sprintf("%s format=%v no-header=%v", name, format, noHeader)
+
+					if noHeader {
+						assert.Len(t, lines, len(item.KV()), desc)
+						assert.False(t, hasHeader, desc)
+					} else {
+						assert.Len(t, lines, len(item.KV())+1, desc)
+						assert.True(t, hasHeader, desc)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestDisplayJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	err := displayJSONL(&droplet{droplets: testDropletList}, &buf, nil)
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"name":"a-droplet"`)
+	assert.Contains(t, lines[1], `"name":"another-droplet"`)
+}
+
+func TestDisplayJSONLColumns(t *testing.T) {
+	var buf bytes.Buffer
+	err := displayJSONL(&droplet{droplets: testDropletList}, &buf, []string{"ID", "Name"})
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"ID\":1,\"Name\":\"a-droplet\"}\n{\"ID\":3,\"Name\":\"another-droplet\"}\n", buf.String())
+
+	err = displayJSONL(&droplet{droplets: testDropletList}, &buf, []string{"Nope"})
+	assert.Error(t, err)
+}
+
+func TestDisplayJSONLSingle(t *testing.T) {
+	var buf bytes.Buffer
+	err := displayJSONL(&account{Account: &do.Account{Account: &godo.Account{Email: "user@example.com"}}}, &buf, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), `"email":"user@example.com"`)
+	assert.Equal(t, 1, strings.Count(buf.String(), "\n"))
+}
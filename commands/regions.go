@@ -35,7 +35,12 @@ func Region() *Command {
 func RunRegionList(c *CmdConfig) error {
 	rs := c.Regions()
 
-	list, err := rs.List()
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	list, err := rs.List(opt)
 	if err != nil {
 		return err
 	}
@@ -34,7 +34,7 @@ func TestRegionCommand(t *testing.T) {
 
 func TestRegionsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.regions.On("List").Return(testRegionList, nil)
+		tm.regions.On("List", allPages).Return(testRegionList, nil)
 
 		err := RunRegionList(config)
 		assert.NoError(t, err)
@@ -13,7 +13,14 @@ limitations under the License.
 
 package commands
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+	"sort"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/spf13/cobra"
+)
 
 // Size creates the size commands heirarchy.
 func Size() *Command {
@@ -25,21 +32,98 @@ func Size() *Command {
 		},
 	}
 
-	CmdBuilder(cmd, RunSizeList, "list", "list sizes", Writer, aliasOpt("ls"),
+	cmdSizeList := CmdBuilder(cmd, RunSizeList, "list", "list sizes", Writer, aliasOpt("ls"),
 		displayerType(&size{}), docCategories("compute"))
+	AddStringFlag(cmdSizeList, doctl.ArgRegionSlug, "", "Only list sizes available in this region")
+	AddStringFlag(cmdSizeList, doctl.ArgSort, "", "Sort sizes by: price")
 
 	return cmd
 }
 
 // RunSizeList all sizes.
 func RunSizeList(c *CmdConfig) error {
+	region, err := c.Doit.GetString(c.NS, doctl.ArgRegionSlug)
+	if err != nil {
+		return err
+	}
+
+	sortBy, err := c.Doit.GetString(c.NS, doctl.ArgSort)
+	if err != nil {
+		return err
+	}
+	if sortBy != "" && sortBy != "price" {
+		return fmt.Errorf("unknown sort order %q: must be price", sortBy)
+	}
+
+	if region != "" {
+		if err := checkRegionExists(c, region); err != nil {
+			return err
+		}
+	}
+
 	sizes := c.Sizes()
 
-	list, err := sizes.List()
+	opt, err := c.ListOptions()
 	if err != nil {
 		return err
 	}
 
+	list, err := sizes.List(opt)
+	if err != nil {
+		return err
+	}
+
+	if region != "" {
+		var filtered do.Sizes
+		for _, s := range list {
+			if sizeInRegion(s, region) {
+				filtered = append(filtered, s)
+			}
+		}
+		list = filtered
+	}
+
+	if sortBy == "price" {
+		sort.Stable(sizesByPrice(list))
+	}
+
 	item := &size{sizes: list}
 	return c.Display(item)
 }
+
+type sizesByPrice do.Sizes
+
+func (s sizesByPrice) Len() int {
+	return len(s)
+}
+func (s sizesByPrice) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+func (s sizesByPrice) Less(i, j int) bool {
+	return s[i].PriceMonthly < s[j].PriceMonthly
+}
+
+func sizeInRegion(s do.Size, region string) bool {
+	for _, r := range s.Regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRegionExists returns an error if slug isn't a known region.
+func checkRegionExists(c *CmdConfig, slug string) error {
+	regions, err := c.Regions().List(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range regions {
+		if r.Slug == slug {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("region %q does not exist", slug)
+}
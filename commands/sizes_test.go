@@ -14,8 +14,10 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
@@ -34,9 +36,52 @@ func TestSizeCommand(t *testing.T) {
 
 func TestSizesList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.sizes.On("List").Return(testSizeList, nil)
+		tm.sizes.On("List", allPages).Return(testSizeList, nil)
 
 		err := RunSizeList(config)
 		assert.NoError(t, err)
 	})
 }
+
+func TestSizesListRegionAndSort(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		sizes := do.Sizes{
+			{Size: &godo.Size{Slug: "large", PriceMonthly: 40, Regions: []string{"dev0"}}},
+			{Size: &godo.Size{Slug: "other", PriceMonthly: 5, Regions: []string{"nyc3"}}},
+			{Size: &godo.Size{Slug: "small", PriceMonthly: 10, Regions: []string{"dev0", "nyc3"}}},
+		}
+		tm.regions.On("List", allPages).Return(testRegionList, nil)
+		tm.sizes.On("List", allPages).Return(sizes, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSort, "price")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Slug,PriceMonthly")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunSizeList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "small\t10.00\nlarge\t40.00\n", buf.String())
+	})
+}
+
+func TestSizesListUnknownRegion(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.regions.On("List", allPages).Return(testRegionList, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "atlantis")
+
+		err := RunSizeList(config)
+		assert.EqualError(t, err, `region "atlantis" does not exist`)
+	})
+}
+
+func TestSizesListUnknownSort(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgSort, "memory")
+
+		err := RunSizeList(config)
+		assert.EqualError(t, err, `unknown sort order "memory": must be price`)
+	})
+}
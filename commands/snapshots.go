@@ -0,0 +1,139 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
+)
+
+// Snapshot creates the snapshot command.
+func Snapshot() *Command {
+	cmd := &Command{
+		Command: &cobra.Command{
+			Use:   "snapshot",
+			Short: "snapshot commands",
+			Long:  "snapshot is used to access snapshot commands",
+		},
+	}
+
+	cmdSnapshotCreate := CmdBuilder(cmd, RunSnapshotCreate, "create <resource-id>", "create a snapshot of a droplet or volume", Writer,
+		aliasOpt("c"))
+	AddStringFlag(cmdSnapshotCreate, doctl.ArgResourceType, "", "Type of resource to snapshot (droplet or volume)", requiredOpt())
+	AddStringFlag(cmdSnapshotCreate, doctl.ArgSnapshotName, "", "Snapshot name", requiredOpt())
+	AddBoolFlag(cmdSnapshotCreate, doctl.ArgCommandWait, false, "Wait for the snapshot to complete")
+	AddIntFlag(cmdSnapshotCreate, doctl.ArgWaitTimeout, 0, waitTimeoutUsage)
+
+	return cmd
+}
+
+// RunSnapshotCreate creates a snapshot of a droplet or volume.
+func RunSnapshotCreate(c *CmdConfig) error {
+	if len(c.Args) != 1 {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+
+	resourceType, err := c.Doit.GetString(c.NS, doctl.ArgResourceType)
+	if err != nil {
+		return err
+	}
+
+	name, err := c.Doit.GetString(c.NS, doctl.ArgSnapshotName)
+	if err != nil {
+		return err
+	}
+
+	switch resourceType {
+	case "droplet":
+		return createDropletSnapshot(c, c.Args[0], name)
+	case "volume":
+		return createVolumeSnapshot(c, c.Args[0], name)
+	default:
+		return fmt.Errorf("unknown resource type %q: must be droplet or volume", resourceType)
+	}
+}
+
+// createDropletSnapshot starts a snapshot action on a droplet. When waiting,
+// the resulting snapshot image is displayed instead of the action.
+func createDropletSnapshot(c *CmdConfig, resourceID, name string) error {
+	id, err := strconv.Atoi(resourceID)
+	if err != nil {
+		return fmt.Errorf("invalid droplet id %q", resourceID)
+	}
+
+	a, err := c.DropletActions().Snapshot(id, name)
+	if err != nil {
+		return err
+	}
+
+	wait, err := c.Doit.GetBool(c.NS, doctl.ArgCommandWait)
+	if err != nil {
+		return err
+	}
+
+	if !wait {
+		return c.Display(&action{actions: do.Actions{*a}})
+	}
+
+	waitTimeout, err := c.Doit.GetInt(c.NS, doctl.ArgWaitTimeout)
+	if err != nil {
+		return err
+	}
+
+	a, err = waitForActive(context.Background(), c.Actions(), a.ID, waitTimeoutOrForever(waitTimeout))
+	if err != nil {
+		return err
+	}
+	if a.Status != godo.ActionCompleted {
+		return fmt.Errorf("snapshot action %d %s", a.ID, a.Status)
+	}
+
+	snapshots, err := c.Droplets().Snapshots(id, nil)
+	if err != nil {
+		return err
+	}
+
+	// Snapshot names aren't unique, so pick the newest one with the name.
+	var found *do.Image
+	for i := range snapshots {
+		if snapshots[i].Name == name && (found == nil || snapshots[i].ID > found.ID) {
+			found = &snapshots[i]
+		}
+	}
+	if found == nil {
+		return fmt.Errorf("snapshot %q of droplet %d not found", name, id)
+	}
+
+	return c.Display(&image{images: do.Images{*found}})
+}
+
+// createVolumeSnapshot snapshots a volume. The API creates volume snapshots
+// synchronously, so there is nothing to wait for.
+func createVolumeSnapshot(c *CmdConfig, volumeID, name string) error {
+	s, err := c.Volumes().CreateSnapshot(&godo.SnapshotCreateRequest{
+		VolumeID: volumeID,
+		Name:     name,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Display(&snapshot{snapshots: []do.Snapshot{*s}})
+}
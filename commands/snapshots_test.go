@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotCommand(t *testing.T) {
+	cmd := Snapshot()
+	assert.NotNil(t, cmd)
+	assertCommandNames(t, cmd, "create")
+}
+
+func TestSnapshotCreateDroplet(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.dropletActions.On("Snapshot", 1, "backup").Return(&testAction, nil)
+
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgResourceType, "droplet")
+		config.Doit.Set(config.NS, doctl.ArgSnapshotName, "backup")
+
+		err := RunSnapshotCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSnapshotCreateDropletWait(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		completed := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionCompleted}}
+		older := do.Image{Image: &godo.Image{ID: 5, Name: "backup"}}
+		newer := do.Image{Image: &godo.Image{ID: 7, Name: "backup"}}
+		other := do.Image{Image: &godo.Image{ID: 9, Name: "other"}}
+
+		tm.dropletActions.On("Snapshot", 1, "backup").Return(&testAction, nil)
+		tm.actions.On("Get", 1).Return(&completed, nil)
+		tm.droplets.On("Snapshots", 1, allPages).Return(do.Images{older, newer, other}, nil)
+
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgResourceType, "droplet")
+		config.Doit.Set(config.NS, doctl.ArgSnapshotName, "backup")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunSnapshotCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSnapshotCreateDropletWaitErrored(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		errored := do.Action{Action: &godo.Action{ID: 1, Status: "errored"}}
+
+		tm.dropletActions.On("Snapshot", 1, "backup").Return(&testAction, nil)
+		tm.actions.On("Get", 1).Return(&errored, nil)
+
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgResourceType, "droplet")
+		config.Doit.Set(config.NS, doctl.ArgSnapshotName, "backup")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+
+		err := RunSnapshotCreate(config)
+		assert.EqualError(t, err, "snapshot action 1 errored")
+	})
+}
+
+func TestSnapshotCreateVolume(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		scr := &godo.SnapshotCreateRequest{VolumeID: "test-volume", Name: "backup"}
+		s := &do.Snapshot{Snapshot: &godo.Snapshot{ID: "snap", VolumeID: "test-volume", Name: "backup"}}
+		tm.volumes.On("CreateSnapshot", scr).Return(s, nil)
+
+		config.Args = append(config.Args, "test-volume")
+		config.Doit.Set(config.NS, doctl.ArgResourceType, "volume")
+		config.Doit.Set(config.NS, doctl.ArgSnapshotName, "backup")
+
+		err := RunSnapshotCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSnapshotCreateUnknownType(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgResourceType, "image")
+		config.Doit.Set(config.NS, doctl.ArgSnapshotName, "backup")
+
+		err := RunSnapshotCreate(config)
+		assert.EqualError(t, err, `unknown resource type "image": must be droplet or volume`)
+	})
+}
@@ -14,12 +14,19 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 	"os/user"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
@@ -28,6 +35,12 @@ import (
 
 var (
 	sshHostRE = regexp.MustCompile("^((?P<m1>\\w+)@)?(?P<m2>.*?)(:(?P<m3>\\d+))?$")
+
+	// sshStdin is where the remote command is read from with --command -.
+	sshStdin io.Reader = os.Stdin
+
+	// sshExit exits with the status of the remote command.
+	sshExit = os.Exit
 )
 
 // SSH creates the ssh commands heirarchy
@@ -38,28 +51,21 @@ func SSH(parent *Command) *Command {
 	path := filepath.Join(usr.HomeDir, ".ssh", "id_rsa")
 
 	cmdSSH := CmdBuilder(parent, RunSSH, "ssh <droplet-id | host>", "ssh to droplet", Writer,
-		docCategories("droplet"))
+		docCategories("droplet"), completeArgs("droplet"))
 	AddStringFlag(cmdSSH, doctl.ArgSSHUser, "root", "ssh user")
 	AddStringFlag(cmdSSH, doctl.ArgsSSHKeyPath, path, "path to private ssh key")
 	AddIntFlag(cmdSSH, doctl.ArgsSSHPort, 22, "port sshd is running on")
 	AddBoolFlag(cmdSSH, doctl.ArgsSSHAgentForwarding, false, "enable ssh agent forwarding")
 	AddBoolFlag(cmdSSH, doctl.ArgsSSHPrivateIP, false, "ssh to private ip instead of public ip")
+	AddStringFlag(cmdSSH, doctl.ArgTagName, "", "run --command on every droplet with this tag")
+	AddIntFlag(cmdSSH, doctl.ArgMaxConcurrency, 10, "maximum number of concurrent ssh sessions with --tag-name")
+	AddStringFlag(cmdSSH, doctl.ArgSSHCommand, "", "command to run on the droplet instead of a shell, - to read it from stdin")
 
 	return cmdSSH
 }
 
 // RunSSH finds a droplet to ssh to given input parameters (name or id).
 func RunSSH(c *CmdConfig) error {
-	if len(c.Args) == 0 {
-		return doctl.NewMissingArgsErr(c.NS)
-	}
-
-	dropletID := c.Args[0]
-
-	if dropletID == "" {
-		return doctl.NewMissingArgsErr(c.NS)
-	}
-
 	user, err := c.Doit.GetString(c.NS, doctl.ArgSSHUser)
 	if err != nil {
 		return err
@@ -86,6 +92,61 @@ func RunSSH(c *CmdConfig) error {
 		return err
 	}
 
+	command, err := c.Doit.GetString(c.NS, doctl.ArgSSHCommand)
+	if err != nil {
+		return err
+	}
+
+	if command == "-" {
+		b, err := ioutil.ReadAll(sshStdin)
+		if err != nil {
+			return err
+		}
+		command = string(b)
+	}
+	opts[doctl.ArgSSHCommand] = command
+
+	tagName, err := c.Doit.GetString(c.NS, doctl.ArgTagName)
+	if err != nil {
+		return err
+	}
+
+	if tagName != "" {
+		if len(c.Args) > 0 {
+			return fmt.Errorf("a droplet can't be combined with --%s", doctl.ArgTagName)
+		}
+
+		if command == "" {
+			return fmt.Errorf("--%s is required with --%s", doctl.ArgSSHCommand, doctl.ArgTagName)
+		}
+
+		maxConcurrency, err := c.Doit.GetInt(c.NS, doctl.ArgMaxConcurrency)
+		if err != nil {
+			return err
+		}
+
+		droplets, err := c.Droplets().ListByTag(tagName, nil)
+		if err != nil {
+			return err
+		}
+
+		if len(droplets) == 0 {
+			return fmt.Errorf("no droplets found with tag %q", tagName)
+		}
+
+		return sshDroplets(c, droplets, user, keyPath, port, opts, privateIPChoice, maxConcurrency)
+	}
+
+	if len(c.Args) == 0 {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+
+	dropletID := c.Args[0]
+
+	if dropletID == "" {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+
 	var droplet *do.Droplet
 
 	ds := c.Droplets()
@@ -100,7 +161,7 @@ func RunSSH(c *CmdConfig) error {
 		droplet = doDroplet
 	} else {
 		// dropletID is a string
-		droplets, err := ds.List()
+		droplets, err := ds.List(nil)
 		if err != nil {
 			return err
 		}
@@ -146,7 +207,132 @@ func RunSSH(c *CmdConfig) error {
 	}
 
 	runner := c.Doit.SSH(user, ip, keyPath, port, opts)
-	return runner.Run()
+	err = runner.Run()
+	if ee, ok := err.(*ssh.ExitError); ok {
+		sshExit(ee.Status)
+		return nil
+	}
+
+	return err
+}
+
+// sshDroplets runs the ssh command on every droplet, at most maxConcurrency
+// at a time. Output lines are prefixed with the droplet name.
+func sshDroplets(c *CmdConfig, droplets do.Droplets, user, keyPath string, port int, opts ssh.Options, privateIP bool, maxConcurrency int) error {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	var mu sync.Mutex
+	var failed []string
+
+	sem := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+	for i := range droplets {
+		droplet := &droplets[i]
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			err := sshDroplet(c, droplet, user, keyPath, port, opts, privateIP)
+			if err != nil {
+				mu.Lock()
+				failed = append(failed, fmt.Sprintf("%s: %v", droplet.Name, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("ssh failed on %d droplet(s):\n %s", len(failed), strings.Join(failed, "\n "))
+	}
+
+	return nil
+}
+
+func sshDroplet(c *CmdConfig, droplet *do.Droplet, user, keyPath string, port int, opts ssh.Options, privateIP bool) error {
+	if user == "" {
+		user = defaultSSHUser(droplet)
+	}
+
+	ip, err := privateIPElsePub(droplet, privateIP)
+	if err != nil {
+		return err
+	}
+
+	if ip == "" {
+		return errors.New("could not find droplet address")
+	}
+
+	stdout := newPrefixWriter(c.Out, droplet.Name)
+	stderr := newPrefixWriter(os.Stderr, droplet.Name)
+	defer stdout.Flush()
+	defer stderr.Flush()
+
+	hostOpts := ssh.Options{}
+	for k, v := range opts {
+		hostOpts[k] = v
+	}
+	hostOpts[ssh.OptStdin] = strings.NewReader("")
+	hostOpts[ssh.OptStdout] = stdout
+	hostOpts[ssh.OptStderr] = stderr
+
+	return c.Doit.SSH(user, ip, keyPath, port, hostOpts).Run()
+}
+
+// prefixWriter prefixes every line written to it. Lines are written whole
+// so output from several droplets doesn't interleave mid-line.
+type prefixWriter struct {
+	out    io.Writer
+	prefix string
+	buf    bytes.Buffer
+}
+
+// prefixWriterMu serializes writes from all prefixWriters.
+var prefixWriterMu sync.Mutex
+
+func newPrefixWriter(out io.Writer, name string) *prefixWriter {
+	return &prefixWriter{out: out, prefix: name + ": "}
+}
+
+func (pw *prefixWriter) Write(p []byte) (int, error) {
+	pw.buf.Write(p)
+
+	for {
+		i := bytes.IndexByte(pw.buf.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+
+		if err := pw.writeLine(pw.buf.Next(i + 1)); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush writes any remaining partial line.
+func (pw *prefixWriter) Flush() error {
+	if pw.buf.Len() == 0 {
+		return nil
+	}
+
+	line := append(pw.buf.Next(pw.buf.Len()), '\n')
+	return pw.writeLine(line)
+}
+
+func (pw *prefixWriter) writeLine(line []byte) error {
+	prefixWriterMu.Lock()
+	defer prefixWriterMu.Unlock()
+
+	_, err := fmt.Fprintf(pw.out, "%s%s", pw.prefix, line)
+	return err
 }
 
 func defaultSSHUser(droplet *do.Droplet) string {
@@ -14,7 +14,11 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
+	"fmt"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/digitalocean/doctl"
@@ -58,7 +62,7 @@ func TestSSH_InvalidID(t *testing.T) {
 
 func TestSSH_UnknownDroplet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.On("List").Return(testDropletList, nil)
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
 
 		config.Args = append(config.Args, "missing")
 
@@ -69,7 +73,7 @@ func TestSSH_UnknownDroplet(t *testing.T) {
 
 func TestSSH_DropletWithNoPublic(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.On("List").Return(testPrivateDropletList, nil)
+		tm.droplets.On("List", allPages).Return(testPrivateDropletList, nil)
 
 		config.Args = append(config.Args, testPrivateDroplet.Name)
 
@@ -89,7 +93,7 @@ func TestSSH_CustomPort(t *testing.T) {
 			return rm
 		}
 
-		tm.droplets.On("List").Return(testDropletList, nil)
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgsSSHPort, "2222")
 		config.Args = append(config.Args, testDroplet.Name)
@@ -110,7 +114,7 @@ func TestSSH_CustomUser(t *testing.T) {
 			return rm
 		}
 
-		tm.droplets.On("List").Return(testDropletList, nil)
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgSSHUser, "foobar")
 		config.Args = append(config.Args, testDroplet.Name)
@@ -131,7 +135,7 @@ func TestSSH_AgentForwarding(t *testing.T) {
 			return rm
 		}
 
-		tm.droplets.On("List").Return(testDropletList, nil)
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgsSSHAgentForwarding, true)
 		config.Args = append(config.Args, testDroplet.Name)
@@ -141,6 +145,134 @@ func TestSSH_AgentForwarding(t *testing.T) {
 	})
 }
 
+func TestSSH_Command(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		rm := &mocks.Runner{}
+		rm.On("Run").Return(nil)
+
+		tc := config.Doit.(*TestConfig)
+		tc.SSHFn = func(user, host, keyPath string, port int, opts ssh.Options) runner.Runner {
+			assert.Equal(t, "uptime", opts[doctl.ArgSSHCommand])
+			return rm
+		}
+
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgSSHCommand, "uptime")
+		config.Args = append(config.Args, testDroplet.Name)
+
+		err := RunSSH(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSSH_CommandFromStdin(t *testing.T) {
+	stdin := sshStdin
+	defer func() { sshStdin = stdin }()
+	sshStdin = strings.NewReader("uptime")
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		rm := &mocks.Runner{}
+		rm.On("Run").Return(nil)
+
+		tc := config.Doit.(*TestConfig)
+		tc.SSHFn = func(user, host, keyPath string, port int, opts ssh.Options) runner.Runner {
+			assert.Equal(t, "uptime", opts[doctl.ArgSSHCommand])
+			return rm
+		}
+
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgSSHCommand, "-")
+		config.Args = append(config.Args, testDroplet.Name)
+
+		err := RunSSH(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSSH_CommandExitStatus(t *testing.T) {
+	exit := sshExit
+	defer func() { sshExit = exit }()
+
+	var status int
+	sshExit = func(code int) { status = code }
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		rm := &mocks.Runner{}
+		rm.On("Run").Return(&ssh.ExitError{Status: 3})
+
+		tc := config.Doit.(*TestConfig)
+		tc.SSHFn = func(user, host, keyPath string, port int, opts ssh.Options) runner.Runner {
+			return rm
+		}
+
+		tm.droplets.On("List", allPages).Return(testDropletList, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgSSHCommand, "false")
+		config.Args = append(config.Args, testDroplet.Name)
+
+		err := RunSSH(config)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, status)
+	})
+}
+
+func TestSSH_Tag(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		var mu sync.Mutex
+		var hosts []string
+
+		tc := config.Doit.(*TestConfig)
+		tc.SSHFn = func(user, host, keyPath string, port int, opts ssh.Options) runner.Runner {
+			mu.Lock()
+			hosts = append(hosts, host)
+			mu.Unlock()
+
+			assert.Equal(t, "uptime", opts[doctl.ArgSSHCommand])
+
+			rm := &mocks.Runner{}
+			if host == "8.8.8.8" {
+				rm.On("Run").Return(&ssh.ExitError{Status: 1})
+			} else {
+				rm.On("Run").Return(nil)
+			}
+			return rm
+		}
+
+		tm.droplets.On("ListByTag", "web", allPages).Return(testDropletList, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgTagName, "web")
+		config.Doit.Set(config.NS, doctl.ArgSSHCommand, "uptime")
+		config.Doit.Set(config.NS, doctl.ArgMaxConcurrency, 1)
+
+		err := RunSSH(config)
+		assert.Error(t, err)
+		assert.Len(t, hosts, len(testDropletList))
+	})
+}
+
+func TestSSH_TagWithoutCommand(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgTagName, "web")
+
+		err := RunSSH(config)
+		assert.Error(t, err)
+	})
+}
+
+func Test_prefixWriter(t *testing.T) {
+	var buf bytes.Buffer
+	pw := newPrefixWriter(&buf, "web-1")
+
+	fmt.Fprint(pw, "one\ntw")
+	fmt.Fprint(pw, "o\nthree")
+	assert.Equal(t, "web-1: one\nweb-1: two\n", buf.String())
+
+	pw.Flush()
+	assert.Equal(t, "web-1: one\nweb-1: two\nweb-1: three\n", buf.String())
+}
+
 func Test_extractHostInfo(t *testing.T) {
 	cases := []struct {
 		s string
@@ -14,6 +14,10 @@ limitations under the License.
 package commands
 
 import (
+	"fmt"
+	"net/http"
+	"strings"
+
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
@@ -32,11 +36,12 @@ func Tags() *Command {
 		IsIndex:       true,
 	}
 
-	CmdBuilder(cmd, RunCmdTagCreate, "create NAME", "create tag", Writer,
+	cmdTagCreate := CmdBuilder(cmd, RunCmdTagCreate, "create NAME", "create tag", Writer,
 		docCategories("tag"))
+	AddBoolFlag(cmdTagCreate, doctl.ArgTagIdempotent, false, "Succeed without changes if the tag already exists")
 
 	CmdBuilder(cmd, RunCmdTagGet, "get NAME", "get tag", Writer,
-		docCategories("tag"))
+		docCategories("tag"), completeArgs("tag"))
 
 	CmdBuilder(cmd, RunCmdTagList, "list", "list tags", Writer,
 		aliasOpt("ls"), docCategories("tag"))
@@ -47,7 +52,17 @@ func Tags() *Command {
 		requiredOpt())
 
 	CmdBuilder(cmd, RunCmdTagDelete, "delete NAME", "delete tag", Writer,
-		docCategories("tag"))
+		docCategories("tag"), completeArgs("tag"))
+
+	cmdTagApply := CmdBuilder(cmd, RunCmdTagApply, "apply NAME", "tag resources", Writer,
+		docCategories("tag"), completeArgs("tag"))
+	AddStringSliceFlag(cmdTagApply, doctl.ArgTagResource, []string{},
+		"Resource to tag as type:id, e.g. droplet:123 or volume:abc (a bare id is a droplet)", requiredOpt())
+
+	cmdTagRemove := CmdBuilder(cmd, RunCmdTagRemove, "remove NAME", "untag resources", Writer,
+		docCategories("tag"), completeArgs("tag"))
+	AddStringSliceFlag(cmdTagRemove, doctl.ArgTagResource, []string{},
+		"Resource to untag as type:id, e.g. droplet:123 or volume:abc (a bare id is a droplet)", requiredOpt())
 
 	return cmd
 }
@@ -61,10 +76,25 @@ func RunCmdTagCreate(c *CmdConfig) error {
 	name := c.Args[0]
 	ts := c.Tags()
 
+	idempotent, err := c.Doit.GetBool(c.NS, doctl.ArgTagIdempotent)
+	if err != nil {
+		return err
+	}
+
 	tcr := &godo.TagCreateRequest{Name: name}
 	t, err := ts.Create(tcr)
 	if err != nil {
-		return err
+		if !idempotent || !isUnprocessable(err) {
+			return err
+		}
+
+		// The API rejects duplicate tags as unprocessable. Fall back to the
+		// existing tag, and report the create error if there isn't one.
+		existing, getErr := ts.Get(name)
+		if getErr != nil {
+			return err
+		}
+		t = existing
 	}
 
 	return c.Display(&tag{tags: do.Tags{*t}})
@@ -89,7 +119,12 @@ func RunCmdTagGet(c *CmdConfig) error {
 // RunCmdTagList runs tag list.
 func RunCmdTagList(c *CmdConfig) error {
 	ts := c.Tags()
-	tags, err := ts.List()
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	tags, err := ts.List(opt)
 	if err != nil {
 		return err
 	}
@@ -125,3 +160,107 @@ func RunCmdTagDelete(c *CmdConfig) error {
 	ts := c.Tags()
 	return ts.Delete(name)
 }
+
+// taggableResourceTypes are the resource types accepted by --resource.
+var taggableResourceTypes = []string{"droplet", "volume", "volume_snapshot", "image", "database"}
+
+// tagResourceTypeAliases maps shorter resource types to the ones the API
+// expects.
+var tagResourceTypeAliases = map[string]string{"snapshot": "volume_snapshot"}
+
+// parseTagResources converts type:id references into tag resources. A
+// reference without a type is a droplet id.
+func parseTagResources(refs []string) ([]godo.Resource, error) {
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("at least one resource is required")
+	}
+
+	var resources []godo.Resource
+	for _, ref := range refs {
+		rType, id := "droplet", ref
+		if i := strings.Index(ref, ":"); i >= 0 {
+			rType, id = ref[:i], ref[i+1:]
+		}
+		if t, ok := tagResourceTypeAliases[rType]; ok {
+			rType = t
+		}
+
+		valid := false
+		for _, t := range taggableResourceTypes {
+			if rType == t {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("invalid resource type %q in %q, must be one of: %s",
+				rType, ref, strings.Join(taggableResourceTypes, ", "))
+		}
+		if id == "" {
+			return nil, fmt.Errorf("resource %q is missing an id", ref)
+		}
+
+		resources = append(resources, godo.Resource{ID: id, Type: godo.ResourceType(rType)})
+	}
+
+	return resources, nil
+}
+
+// tagResourceFn applies or removes a tag from a single resource.
+type tagResourceFn func(name string, r godo.Resource) error
+
+// RunCmdTagApply runs tag apply.
+func RunCmdTagApply(c *CmdConfig) error {
+	ts := c.Tags()
+	return runTagResources(c, "tagged", func(name string, r godo.Resource) error {
+		return ts.TagResources(name, &godo.TagResourcesRequest{Resources: []godo.Resource{r}})
+	})
+}
+
+// RunCmdTagRemove runs tag remove.
+func RunCmdTagRemove(c *CmdConfig) error {
+	ts := c.Tags()
+	return runTagResources(c, "untagged", func(name string, r godo.Resource) error {
+		return ts.UntagResources(name, &godo.UntagResourcesRequest{Resources: []godo.Resource{r}})
+	})
+}
+
+// runTagResources calls fn for each resource so that failures are reported
+// per resource rather than failing the whole batch.
+func runTagResources(c *CmdConfig, verb string, fn tagResourceFn) error {
+	if len(c.Args) != 1 {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+	name := c.Args[0]
+
+	refs, err := c.Doit.GetStringSlice(c.NS, doctl.ArgTagResource)
+	if err != nil {
+		return err
+	}
+
+	resources, err := parseTagResources(refs)
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	for _, r := range resources {
+		if err := fn(name, r); err != nil {
+			failed = append(failed, fmt.Sprintf("%s:%s: %v", r.Type, r.ID, err))
+			continue
+		}
+		fmt.Fprintf(c.Out, "%s %s:%s\n", verb, r.Type, r.ID)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed on %d of %d resource(s):\n %s", len(failed), len(resources), strings.Join(failed, "\n "))
+	}
+
+	return nil
+}
+
+// isUnprocessable reports whether err is an API 422 response.
+func isUnprocessable(err error) bool {
+	er, ok := err.(*godo.ErrorResponse)
+	return ok && er.Response != nil && er.Response.StatusCode == http.StatusUnprocessableEntity
+}
@@ -15,6 +15,7 @@ package commands
 
 import (
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/digitalocean/doctl"
@@ -41,7 +42,7 @@ var (
 func TestTTagCommand(t *testing.T) {
 	cmd := Tags()
 	assert.NotNil(t, cmd)
-	assertCommandNames(t, cmd, "create", "get", "update", "delete", "list")
+	assertCommandNames(t, cmd, "create", "get", "update", "delete", "list", "apply", "remove")
 }
 
 func TestTagGet(t *testing.T) {
@@ -57,7 +58,7 @@ func TestTagGet(t *testing.T) {
 
 func TestTagList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.tags.On("List").Return(testTagList, nil)
+		tm.tags.On("List", allPages).Return(testTagList, nil)
 
 		err := RunCmdTagList(config)
 		assert.NoError(t, err)
@@ -75,6 +76,45 @@ func TestTagCreate(t *testing.T) {
 	})
 }
 
+func TestTagCreateIdempotent(t *testing.T) {
+	exists := &godo.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusUnprocessableEntity},
+		Message:  "tag already exists",
+	}
+	other := &godo.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusInternalServerError},
+		Message:  "server error",
+	}
+
+	cases := []struct {
+		name       string
+		idempotent bool
+		createErr  error
+		get        bool
+		err        error
+	}{
+		{name: "existing tag", idempotent: true, createErr: exists, get: true},
+		{name: "flag not set", createErr: exists, err: exists},
+		{name: "other error", idempotent: true, createErr: other, err: other},
+	}
+
+	for _, tc := range cases {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tcr := godo.TagCreateRequest{Name: "my-tag"}
+			tm.tags.On("Create", &tcr).Return(nil, tc.createErr)
+			if tc.get {
+				tm.tags.On("Get", "my-tag").Return(&testTag, nil)
+			}
+
+			config.Args = append(config.Args, "my-tag")
+			config.Doit.Set(config.NS, doctl.ArgTagIdempotent, tc.idempotent)
+
+			err := RunCmdTagCreate(config)
+			assert.Equal(t, tc.err, err, tc.name)
+		})
+	}
+}
+
 func TestTagDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.tags.On("Delete", "my-tag").Return(nil)
@@ -108,3 +148,76 @@ func TestTagUpdateMissingName(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestTagApply(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		for _, r := range []godo.Resource{
+			{ID: "123", Type: godo.DropletResourceType},
+			{ID: "abc", Type: godo.ResourceType("volume")},
+			{ID: "456", Type: godo.DropletResourceType},
+		} {
+			trr := &godo.TagResourcesRequest{Resources: []godo.Resource{r}}
+			tm.tags.On("TagResources", "mytag", trr).Return(nil)
+		}
+
+		config.Args = append(config.Args, "mytag")
+		config.Doit.Set(config.NS, doctl.ArgTagResource, []string{"droplet:123", "volume:abc", "456"})
+
+		err := RunCmdTagApply(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestTagApplyPartialFailure(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		ok := &godo.TagResourcesRequest{Resources: []godo.Resource{{ID: "123", Type: godo.DropletResourceType}}}
+		bad := &godo.TagResourcesRequest{Resources: []godo.Resource{{ID: "abc", Type: godo.ResourceType("volume")}}}
+		tm.tags.On("TagResources", "mytag", ok).Return(nil)
+		tm.tags.On("TagResources", "mytag", bad).Return(errors.New("not found"))
+
+		config.Args = append(config.Args, "mytag")
+		config.Doit.Set(config.NS, doctl.ArgTagResource, []string{"droplet:123", "volume:abc"})
+
+		err := RunCmdTagApply(config)
+		assert.EqualError(t, err, "failed on 1 of 2 resource(s):\n volume:abc: not found")
+	})
+}
+
+func TestTagApplyInvalidType(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "mytag")
+		config.Doit.Set(config.NS, doctl.ArgTagResource, []string{"bucket:abc"})
+
+		err := RunCmdTagApply(config)
+		assert.Error(t, err)
+		tm.tags.AssertNotCalled(t, "TagResources")
+	})
+}
+
+func TestTagRemove(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		urr := &godo.UntagResourcesRequest{Resources: []godo.Resource{{ID: "snap-1", Type: godo.ResourceType("volume_snapshot")}}}
+		tm.tags.On("UntagResources", "mytag", urr).Return(nil)
+
+		config.Args = append(config.Args, "mytag")
+		config.Doit.Set(config.NS, doctl.ArgTagResource, []string{"snapshot:snap-1"})
+
+		err := RunCmdTagRemove(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestTagApplySnapshotType(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		for _, id := range []string{"snap-1", "snap-2"} {
+			trr := &godo.TagResourcesRequest{Resources: []godo.Resource{{ID: id, Type: godo.ResourceType("volume_snapshot")}}}
+			tm.tags.On("TagResources", "mytag", trr).Return(nil)
+		}
+
+		config.Args = append(config.Args, "mytag")
+		config.Doit.Set(config.NS, doctl.ArgTagResource, []string{"snapshot:snap-1", "volume_snapshot:snap-2"})
+
+		err := RunCmdTagApply(config)
+		assert.NoError(t, err)
+	})
+}
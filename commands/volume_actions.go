@@ -1,11 +1,14 @@
 package commands
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
 )
 
 type volumeActionFn func(das do.VolumeActionsService) (*do.Action, error)
@@ -24,11 +27,15 @@ func performVolumeAction(c *CmdConfig, fn volumeActionFn) error {
 	}
 
 	if wait {
-		a, err = actionWait(c, a.ID, 5)
+		waitTimeout, err := c.Doit.GetInt(c.NS, doctl.ArgWaitTimeout)
 		if err != nil {
 			return err
 		}
 
+		a, err = waitForActive(context.Background(), c.Actions(), a.ID, waitTimeoutOrForever(waitTimeout))
+		if err != nil {
+			return err
+		}
 	}
 
 	item := &action{actions: do.Actions{*a}}
@@ -45,11 +52,16 @@ func VolumeAction() *Command {
 		},
 	}
 
-	CmdBuilder(cmd, RunVolumeAttach, "attach <volume-id> <droplet-id>", "attach a volume", Writer,
+	cmdVolumeAttach := CmdBuilder(cmd, RunVolumeAttach, "attach <volume-id> [<droplet-id>]", "attach a volume", Writer,
 		aliasOpt("a"))
+	AddStringFlag(cmdVolumeAttach, doctl.ArgDropletName, "", "Name of the droplet in the volume's region to attach to")
+	AddBoolFlag(cmdVolumeAttach, doctl.ArgCommandWait, false, "Wait for the volume to be attached")
+	AddIntFlag(cmdVolumeAttach, doctl.ArgWaitTimeout, 300, waitTimeoutUsage)
 
-	CmdBuilder(cmd, RunVolumeDetach, "detach <volume-id>", "detach a volume", Writer,
+	cmdVolumeDetach := CmdBuilder(cmd, RunVolumeDetach, "detach <volume-id>", "detach a volume", Writer,
 		aliasOpt("d"))
+	AddBoolFlag(cmdVolumeDetach, doctl.ArgCommandWait, false, "Wait for the volume to be detached")
+	AddIntFlag(cmdVolumeDetach, doctl.ArgWaitTimeout, 300, waitTimeoutUsage)
 
 	return cmd
 
@@ -58,21 +70,67 @@ func VolumeAction() *Command {
 // RunVolumeAttach attaches a volume to a droplet.
 func RunVolumeAttach(c *CmdConfig) error {
 	fn := func(das do.VolumeActionsService) (*do.Action, error) {
-		if len(c.Args) != 2 {
-			return nil, doctl.NewMissingArgsErr(c.NS)
-		}
-		volumeID := c.Args[0]
-		dropletID, err := strconv.Atoi(c.Args[1])
+		dropletName, err := c.Doit.GetString(c.NS, doctl.ArgDropletName)
 		if err != nil {
 			return nil, err
+		}
 
+		var dropletID int
+		switch {
+		case dropletName != "" && len(c.Args) == 1:
+			dropletID, err = volumeDropletByName(c, c.Args[0], dropletName)
+		case dropletName == "" && len(c.Args) == 2:
+			dropletID, err = strconv.Atoi(c.Args[1])
+		case dropletName != "" && len(c.Args) == 2:
+			err = fmt.Errorf("a droplet id can't be combined with --%s", doctl.ArgDropletName)
+		default:
+			err = doctl.NewMissingArgsErr(c.NS)
+		}
+		if err != nil {
+			return nil, err
 		}
-		a, err := das.Attach(volumeID, dropletID)
+
+		a, err := das.Attach(c.Args[0], dropletID)
 		return a, err
 	}
 	return performVolumeAction(c, fn)
 }
 
+// volumeDropletByName finds the id of the droplet named name in the region of
+// the volume.
+func volumeDropletByName(c *CmdConfig, volumeID, name string) (int, error) {
+	v, err := c.Volumes().Get(volumeID)
+	if err != nil {
+		return 0, err
+	}
+	if v.Region == nil {
+		return 0, fmt.Errorf("volume %s has no region, use a droplet id", volumeID)
+	}
+	region := v.Region.Slug
+
+	droplets, err := c.Droplets().List(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var ids []string
+	for _, d := range droplets {
+		if d.Name == name && d.Region != nil && d.Region.Slug == region {
+			ids = append(ids, strconv.Itoa(d.ID))
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("no droplet named %q in region %q", name, region)
+	case 1:
+		return strconv.Atoi(ids[0])
+	default:
+		return 0, fmt.Errorf("droplet name %q is ambiguous in region %q, use one of the ids: %s",
+			name, region, strings.Join(ids, ", "))
+	}
+}
+
 // RunVolumeDetach detaches a volume from the droplet it's attached to.
 func RunVolumeDetach(c *CmdConfig) error {
 	fn := func(das do.VolumeActionsService) (*do.Action, error) {
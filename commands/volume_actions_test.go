@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,6 +27,72 @@ func TestVolumeActionsAttach(t *testing.T) {
 	})
 }
 
+func TestVolumeActionsAttachByDropletName(t *testing.T) {
+	newDroplet := func(id int, name, region string) do.Droplet {
+		return do.Droplet{Droplet: &godo.Droplet{ID: id, Name: name, Region: &godo.Region{Slug: region}}}
+	}
+	droplets := do.Droplets{
+		newDroplet(10, "web-1", "atlantis"),
+		newDroplet(11, "web-1", "nyc3"),
+		newDroplet(12, "db", "atlantis"),
+		newDroplet(13, "db", "atlantis"),
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.volumes.On("Get", testVolume.ID).Return(&testVolume, nil)
+		tm.droplets.On("List", allPages).Return(droplets, nil)
+		tm.volumeActions.On("Attach", testVolume.ID, 10).Return(&testAction, nil)
+
+		config.Args = append(config.Args, testVolume.ID)
+		config.Doit.Set(config.NS, doctl.ArgDropletName, "web-1")
+
+		err := RunVolumeAttach(config)
+		assert.NoError(t, err)
+	})
+
+	for name, msg := range map[string]string{
+		"db":  `droplet name "db" is ambiguous in region "atlantis", use one of the ids: 12, 13`,
+		"api": `no droplet named "api" in region "atlantis"`,
+	} {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.volumes.On("Get", testVolume.ID).Return(&testVolume, nil)
+			tm.droplets.On("List", allPages).Return(droplets, nil)
+
+			config.Args = append(config.Args, testVolume.ID)
+			config.Doit.Set(config.NS, doctl.ArgDropletName, name)
+
+			err := RunVolumeAttach(config)
+			assert.EqualError(t, err, msg)
+		})
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		noRegion := do.Volume{Volume: &godo.Volume{ID: testVolume.ID}}
+		tm.volumes.On("Get", testVolume.ID).Return(&noRegion, nil)
+
+		config.Args = append(config.Args, testVolume.ID)
+		config.Doit.Set(config.NS, doctl.ArgDropletName, "web-1")
+
+		err := RunVolumeAttach(config)
+		assert.EqualError(t, err, fmt.Sprintf("volume %s has no region, use a droplet id", testVolume.ID))
+	})
+}
+
+func TestVolumeActionsAttachWait(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		completed := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionCompleted}}
+		tm.volumeActions.On("Attach", testVolume.ID, testDroplet.ID).Return(&testAction, nil)
+		tm.actions.On("Get", 1).Return(&completed, nil)
+
+		config.Args = append(config.Args, testVolume.ID, fmt.Sprintf("%d", testDroplet.ID))
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+		config.Doit.Set(config.NS, doctl.ArgWaitTimeout, 300)
+
+		err := RunVolumeAttach(config)
+		assert.NoError(t, err)
+	})
+}
+
 func TestVolumeActionsDetach(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.volumeActions.On("Detach", testVolume.ID).Return(&testAction, nil)
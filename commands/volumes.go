@@ -1,11 +1,15 @@
 package commands
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
 )
 
 // Volume creates the Volume command
@@ -24,17 +28,22 @@ func Volume() *Command {
 	cmdVolumeCreate := CmdBuilder(cmd, RunVolumeCreate, "create [name]", "create a volume", Writer,
 		aliasOpt("c"), displayerType(&volume{}))
 
-	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeSize, "4TiB", "Volume size",
-		requiredOpt())
+	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeSize, "", "Volume size, defaults to the snapshot's minimum size with --snapshot")
 	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeDesc, "", "Volume description")
-	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeRegion, "", "Volume region",
-		requiredOpt())
+	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeRegion, "", "Volume region, defaults to the snapshot's region with --snapshot")
+	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeSnapshot, "", "Snapshot ID or name to create the volume from")
 
 	CmdBuilder(cmd, RunVolumeDelete, "delete [ID]", "delete a volume", Writer,
-		aliasOpt("rm"))
+		aliasOpt("rm"), completeArgs("volume"))
 
 	CmdBuilder(cmd, RunVolumeGet, "get [ID]", "get a volume", Writer, aliasOpt("g"),
-		displayerType(&volume{}))
+		displayerType(&volume{}), completeArgs("volume"))
+
+	cmdVolumeResize := CmdBuilder(cmd, RunVolumeResize, "resize [ID]", "resize a volume", Writer,
+		displayerType(&action{}), completeArgs("volume"))
+	AddStringFlag(cmdVolumeResize, doctl.ArgVolumeSize, "", "New volume size", requiredOpt())
+	AddBoolFlag(cmdVolumeResize, doctl.ArgCommandWait, false, "Wait for the resize to complete")
+	AddIntFlag(cmdVolumeResize, doctl.ArgWaitTimeout, 300, waitTimeoutUsage)
 
 	return cmd
 
@@ -43,7 +52,12 @@ func Volume() *Command {
 // RunVolumeList returns a list of volumes.
 func RunVolumeList(c *CmdConfig) error {
 	al := c.Volumes()
-	d, err := al.List()
+	opt, err := c.ListOptions()
+	if err != nil {
+		return err
+	}
+
+	d, err := al.List(opt)
 	if err != nil {
 		return err
 	}
@@ -63,10 +77,6 @@ func RunVolumeCreate(c *CmdConfig) error {
 	if err != nil {
 		return err
 	}
-	size, err := humanize.ParseBytes(sizeStr)
-	if err != nil {
-		return err
-	}
 
 	desc, err := c.Doit.GetString(c.NS, doctl.ArgVolumeDesc)
 	if err != nil {
@@ -79,16 +89,75 @@ func RunVolumeCreate(c *CmdConfig) error {
 
 	}
 
+	snapshot, err := c.Doit.GetString(c.NS, doctl.ArgVolumeSnapshot)
+	if err != nil {
+		return err
+	}
+
 	var createVolume godo.VolumeCreateRequest
 
 	createVolume.Name = name
-	createVolume.SizeGigaBytes = int64(size / (1 << 30))
 	createVolume.Description = desc
 	createVolume.Region = region
 
 	al := c.Volumes()
 
-	d, err := al.CreateVolume(&createVolume)
+	if snapshot == "" {
+		if sizeStr == "" {
+			return fmt.Errorf("--%s is required", doctl.ArgVolumeSize)
+		}
+		if region == "" {
+			return fmt.Errorf("--%s is required", doctl.ArgVolumeRegion)
+		}
+
+		if createVolume.SizeGigaBytes, err = volumeSizeGiB(sizeStr); err != nil {
+			return err
+		}
+
+		d, err := al.CreateVolume(&createVolume)
+		if err != nil {
+			return err
+		}
+		item := &volume{volumes: []do.Volume{*d}}
+		return c.Display(item)
+	}
+
+	s, err := resolveVolumeSnapshot(al, snapshot)
+	if err != nil {
+		return err
+	}
+
+	inRegion := false
+	for _, r := range s.Regions {
+		if r == region {
+			inRegion = true
+		}
+	}
+
+	switch {
+	case region == "" && len(s.Regions) == 1:
+		createVolume.Region = s.Regions[0]
+	case region == "":
+		return fmt.Errorf("--%s is required, snapshot %q is available in: %s",
+			doctl.ArgVolumeRegion, snapshot, strings.Join(s.Regions, ", "))
+	case !inRegion:
+		return fmt.Errorf("snapshot %q is not in region %q, found in: %s",
+			snapshot, region, strings.Join(s.Regions, ", "))
+	}
+
+	createVolume.SizeGigaBytes = int64(s.MinDiskSize)
+	if sizeStr != "" {
+		size, err := volumeSizeGiB(sizeStr)
+		if err != nil {
+			return err
+		}
+		if size < int64(s.MinDiskSize) {
+			return fmt.Errorf("volume size must be at least %dGiB to hold snapshot %q", s.MinDiskSize, snapshot)
+		}
+		createVolume.SizeGigaBytes = size
+	}
+
+	d, err := al.CreateVolumeFromSnapshot(&createVolume, s.ID)
 	if err != nil {
 		return err
 	}
@@ -97,6 +166,48 @@ func RunVolumeCreate(c *CmdConfig) error {
 
 }
 
+// volumeSizeGiB parses a human readable size into whole GiB.
+func volumeSizeGiB(s string) (int64, error) {
+	size, err := humanize.ParseBytes(s)
+	if err != nil {
+		return 0, err
+	}
+	return int64(size / (1 << 30)), nil
+}
+
+// resolveVolumeSnapshot finds the volume snapshot with the given ID or,
+// failing that, the only snapshot with the given name.
+func resolveVolumeSnapshot(vs do.VolumesService, idOrName string) (*do.VolumeSnapshot, error) {
+	snapshots, err := vs.ListSnapshots()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []do.VolumeSnapshot
+	for _, s := range snapshots {
+		if s.ID == idOrName {
+			return &s, nil
+		}
+		if s.Name == idOrName {
+			matches = append(matches, s)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("volume snapshot %q does not exist", idOrName)
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, s := range matches {
+			ids[i] = s.ID
+		}
+		return nil, fmt.Errorf("volume snapshot name %q is ambiguous, use one of the ids: %s",
+			idOrName, strings.Join(ids, ", "))
+	}
+}
+
 // RunVolumeDelete deletes a volume.
 func RunVolumeDelete(c *CmdConfig) error {
 	if len(c.Args) == 0 {
@@ -127,3 +238,62 @@ func RunVolumeGet(c *CmdConfig) error {
 	item := &volume{volumes: []do.Volume{*d}}
 	return c.Display(item)
 }
+
+// RunVolumeResize resizes a volume.
+func RunVolumeResize(c *CmdConfig) error {
+	if len(c.Args) == 0 {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+	id := c.Args[0]
+
+	sizeStr, err := c.Doit.GetString(c.NS, doctl.ArgVolumeSize)
+	if err != nil {
+		return err
+	}
+	sizeGigaBytes, err := volumeSizeGiB(sizeStr)
+	if err != nil {
+		return err
+	}
+
+	wait, err := c.Doit.GetBool(c.NS, doctl.ArgCommandWait)
+	if err != nil {
+		return err
+	}
+
+	waitTimeout, err := c.Doit.GetInt(c.NS, doctl.ArgWaitTimeout)
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Volumes().Get(id)
+	if err != nil {
+		return err
+	}
+
+	if sizeGigaBytes <= v.SizeGigaBytes {
+		return fmt.Errorf("volume %s is %d GiB, new size must be larger", id, v.SizeGigaBytes)
+	}
+	if v.Region == nil {
+		return fmt.Errorf("volume %s has no region, unable to resize it", id)
+	}
+
+	vas := c.VolumeActions()
+	a, err := vas.Resize(id, int(sizeGigaBytes), v.Region.Slug)
+	if err != nil {
+		return err
+	}
+
+	if wait {
+		a, err = waitForActive(context.Background(), c.Actions(), a.ID, waitTimeoutOrForever(waitTimeout))
+		if err != nil {
+			return fmt.Errorf("volume %s: %v", id, err)
+		}
+
+		if a.Status != godo.ActionCompleted {
+			return fmt.Errorf("volume %s action %d finished with status %q", id, a.ID, a.Status)
+		}
+	}
+
+	item := &action{actions: do.Actions{*a}}
+	return c.Display(item)
+}
@@ -28,7 +28,7 @@ var (
 func TestVolumeCommand(t *testing.T) {
 	cmd := Volume()
 	assert.NotNil(t, cmd)
-	assertCommandNames(t, cmd, "create", "delete", "get", "list")
+	assertCommandNames(t, cmd, "create", "delete", "get", "list", "resize")
 }
 
 func TestVolumesGet(t *testing.T) {
@@ -44,7 +44,7 @@ func TestVolumesGet(t *testing.T) {
 
 func TestVolumesList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumes.On("List").Return(testVolumeList, nil)
+		tm.volumes.On("List", allPages).Return(testVolumeList, nil)
 
 		err := RunVolumeList(config)
 		assert.NoError(t, err)
@@ -72,6 +72,85 @@ func TestVolumeCreate(t *testing.T) {
 	})
 }
 
+func TestVolumeCreateRequiresSize(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "test-volume")
+		config.Doit.Set(config.NS, doctl.ArgVolumeRegion, "atlantis")
+
+		err := RunVolumeCreate(config)
+		assert.EqualError(t, err, "--size is required")
+	})
+}
+
+var testVolumeSnapshots = []do.VolumeSnapshot{
+	{ID: "snap-1", Name: "db-backup", Regions: []string{"atlantis"}, MinDiskSize: 10},
+	{ID: "snap-2", Name: "shared", Regions: []string{"atlantis"}, MinDiskSize: 10},
+	{ID: "snap-3", Name: "shared", Regions: []string{"lemuria"}, MinDiskSize: 10},
+}
+
+func TestVolumeCreateFromSnapshot(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tcr := godo.VolumeCreateRequest{
+			Name:          "test-volume",
+			SizeGigaBytes: 10,
+			Region:        "atlantis",
+		}
+		tm.volumes.On("ListSnapshots").Return(testVolumeSnapshots, nil)
+		tm.volumes.On("CreateVolumeFromSnapshot", &tcr, "snap-1").Return(&testVolume, nil)
+
+		config.Args = append(config.Args, "test-volume")
+		config.Doit.Set(config.NS, doctl.ArgVolumeSnapshot, "db-backup")
+
+		err := RunVolumeCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestVolumeCreateFromSnapshotID(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tcr := godo.VolumeCreateRequest{
+			Name:          "test-volume",
+			SizeGigaBytes: 100,
+			Region:        "lemuria",
+		}
+		tm.volumes.On("ListSnapshots").Return(testVolumeSnapshots, nil)
+		tm.volumes.On("CreateVolumeFromSnapshot", &tcr, "snap-3").Return(&testVolume, nil)
+
+		config.Args = append(config.Args, "test-volume")
+		config.Doit.Set(config.NS, doctl.ArgVolumeSnapshot, "snap-3")
+		config.Doit.Set(config.NS, doctl.ArgVolumeRegion, "lemuria")
+		config.Doit.Set(config.NS, doctl.ArgVolumeSize, "100GiB")
+
+		err := RunVolumeCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestVolumeCreateFromSnapshotErrors(t *testing.T) {
+	cases := []struct {
+		snapshot, region, size, err string
+	}{
+		{snapshot: "missing", err: `volume snapshot "missing" does not exist`},
+		{snapshot: "shared", err: `volume snapshot name "shared" is ambiguous, use one of the ids: snap-2, snap-3`},
+		{snapshot: "db-backup", region: "lemuria", err: `snapshot "db-backup" is not in region "lemuria", found in: atlantis`},
+		{snapshot: "db-backup", size: "5GiB", err: `volume size must be at least 10GiB to hold snapshot "db-backup"`},
+	}
+
+	for _, c := range cases {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.volumes.On("ListSnapshots").Return(testVolumeSnapshots, nil)
+
+			config.Args = append(config.Args, "test-volume")
+			config.Doit.Set(config.NS, doctl.ArgVolumeSnapshot, c.snapshot)
+			config.Doit.Set(config.NS, doctl.ArgVolumeRegion, c.region)
+			config.Doit.Set(config.NS, doctl.ArgVolumeSize, c.size)
+
+			err := RunVolumeCreate(config)
+			assert.EqualError(t, err, c.err)
+		})
+	}
+}
+
 func TestVolumesDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.volumes.On("DeleteVolume", "test-volume").Return(nil)
@@ -82,3 +161,74 @@ func TestVolumesDelete(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestVolumeResize(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.volumes.On("Get", testVolume.ID).Return(&testVolume, nil)
+		tm.volumeActions.On("Resize", testVolume.ID, 200, "atlantis").Return(&testAction, nil)
+
+		config.Args = append(config.Args, testVolume.ID)
+		config.Doit.Set(config.NS, doctl.ArgVolumeSize, "200GiB")
+
+		err := RunVolumeResize(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestVolumeResizeWait(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		completed := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionCompleted}}
+		tm.volumes.On("Get", testVolume.ID).Return(&testVolume, nil)
+		tm.volumeActions.On("Resize", testVolume.ID, 200, "atlantis").Return(&testAction, nil)
+		tm.actions.On("Get", 1).Return(&completed, nil)
+
+		config.Args = append(config.Args, testVolume.ID)
+		config.Doit.Set(config.NS, doctl.ArgVolumeSize, "200GiB")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+
+		err := RunVolumeResize(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestVolumeResizeWaitTimeout(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		inProgress := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionInProgress}}
+		tm.volumes.On("Get", testVolume.ID).Return(&testVolume, nil)
+		tm.volumeActions.On("Resize", testVolume.ID, 200, "atlantis").Return(&testAction, nil)
+		tm.actions.On("Get", 1).Return(&inProgress, nil)
+
+		config.Args = append(config.Args, testVolume.ID)
+		config.Doit.Set(config.NS, doctl.ArgVolumeSize, "200GiB")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+		config.Doit.Set(config.NS, doctl.ArgWaitTimeout, 1)
+
+		err := RunVolumeResize(config)
+		assert.Error(t, err)
+	})
+}
+
+func TestVolumeResizeShrink(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.volumes.On("Get", testVolume.ID).Return(&testVolume, nil)
+
+		config.Args = append(config.Args, testVolume.ID)
+		config.Doit.Set(config.NS, doctl.ArgVolumeSize, "50GiB")
+
+		err := RunVolumeResize(config)
+		assert.EqualError(t, err, "volume "+testVolume.ID+" is 100 GiB, new size must be larger")
+	})
+}
+
+func TestVolumeResizeNoRegion(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		noRegion := do.Volume{Volume: &godo.Volume{ID: testVolume.ID, SizeGigaBytes: 100}}
+		tm.volumes.On("Get", testVolume.ID).Return(&noRegion, nil)
+
+		config.Args = append(config.Args, testVolume.ID)
+		config.Doit.Set(config.NS, doctl.ArgVolumeSize, "200GiB")
+
+		err := RunVolumeResize(config)
+		assert.EqualError(t, err, "volume "+testVolume.ID+" has no region, unable to resize it")
+	})
+}
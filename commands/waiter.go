@@ -0,0 +1,111 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"golang.org/x/net/context"
+)
+
+var (
+	// waitMinInterval is the delay before the first re-poll of an action.
+	waitMinInterval = 1 * time.Second
+	// waitMaxInterval is the longest delay between polls of an action.
+	waitMaxInterval = 10 * time.Second
+)
+
+// waitForever is the timeout to pass to wait until the action is done or
+// the context is cancelled.
+const waitForever time.Duration = -1
+
+// waitTimeoutError is returned when an action is still in progress after
+// the wait timeout.
+type waitTimeoutError struct {
+	actionID int
+	timeout  time.Duration
+}
+
+func (e *waitTimeoutError) Error() string {
+	return fmt.Sprintf("timed out waiting for action %d after %s", e.actionID, e.timeout)
+}
+
+// waitTimeoutUsage is the help text of every --wait-timeout flag.
+const waitTimeoutUsage = "Seconds to wait for the action to complete, 0 waits forever"
+
+// waitTimeoutOrForever converts a --wait-timeout in seconds, where 0 means no
+// limit, to a timeout for waitForActive.
+func waitTimeoutOrForever(seconds int) time.Duration {
+	if seconds <= 0 {
+		return waitForever
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// waitForActive polls an action until it is no longer in progress and
+// returns it. A negative timeout, such as waitForever, waits until ctx is done.
+func waitForActive(ctx context.Context, as do.ActionsService, actionID int, timeout time.Duration) (*do.Action, error) {
+	return pollAction(ctx, as, actionID, timeout, waitMaxInterval, nil)
+}
+
+// pollAction polls an action, doubling the delay between polls up to
+// maxInterval. If progress is not nil, status changes are written to it.
+func pollAction(ctx context.Context, as do.ActionsService, actionID int, timeout, maxInterval time.Duration, progress io.Writer) (*do.Action, error) {
+	var deadline time.Time
+	if timeout >= 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	interval := waitMinInterval
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+	lastStatus := ""
+
+	for {
+		a, err := as.Get(actionID)
+		if err != nil {
+			return nil, err
+		}
+
+		if progress != nil && a.Status != lastStatus {
+			fmt.Fprintf(progress, "action %d (%s): %s\n", a.ID, a.Type, a.Status)
+			lastStatus = a.Status
+		}
+
+		if a.Status != godo.ActionInProgress {
+			return a, nil
+		}
+
+		// give up now rather than sleep past the deadline.
+		if !deadline.IsZero() && time.Now().Add(interval).After(deadline) {
+			return nil, &waitTimeoutError{actionID: actionID, timeout: timeout}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
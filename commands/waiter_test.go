@@ -0,0 +1,77 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/digitalocean/doctl/do"
+	domocks "github.com/digitalocean/doctl/do/mocks"
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestWaitForActive(t *testing.T) {
+	min := waitMinInterval
+	defer func() { waitMinInterval = min }()
+	waitMinInterval = time.Millisecond
+
+	inProgress := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionInProgress}}
+	completed := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionCompleted}}
+
+	as := &domocks.ActionsService{}
+	as.On("Get", 1).Return(&inProgress, nil).Twice()
+	as.On("Get", 1).Return(&completed, nil).Once()
+
+	a, err := waitForActive(context.Background(), as, 1, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, godo.ActionCompleted, a.Status)
+	as.AssertExpectations(t)
+}
+
+func TestWaitForActiveErrored(t *testing.T) {
+	errored := do.Action{Action: &godo.Action{ID: 1, Status: "errored"}}
+
+	as := &domocks.ActionsService{}
+	as.On("Get", 1).Return(&errored, nil)
+
+	a, err := waitForActive(context.Background(), as, 1, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "errored", a.Status)
+}
+
+func TestWaitForActiveTimeout(t *testing.T) {
+	inProgress := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionInProgress}}
+
+	as := &domocks.ActionsService{}
+	as.On("Get", 1).Return(&inProgress, nil)
+
+	_, err := waitForActive(context.Background(), as, 1, time.Millisecond)
+	assert.EqualError(t, err, "timed out waiting for action 1 after 1ms")
+}
+
+func TestWaitForActiveCancel(t *testing.T) {
+	inProgress := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionInProgress}}
+
+	as := &domocks.ActionsService{}
+	as.On("Get", 1).Return(&inProgress, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := waitForActive(ctx, as, 1, waitForever)
+	assert.Equal(t, context.Canceled, err)
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/digitalocean/godo"
+)
+
+// zoneSkip is a resource record from a zone file that will not be imported.
+type zoneSkip struct {
+	Line int
+	Name string
+	Type string
+}
+
+// zoneLine is a logical zone file entry. Entries wrapped in parentheses
+// span several physical lines.
+type zoneLine struct {
+	num        int
+	blankOwner bool
+	fields     []string
+}
+
+// parseZoneFile parses a BIND zone file for domain and returns the records
+// that can be created and the ones that were skipped.
+func parseZoneFile(r io.Reader, domain string) ([]*godo.DomainRecordEditRequest, []zoneSkip, error) {
+	lines, err := readZoneLines(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	origin := domain + "."
+	owner := ""
+
+	var reqs []*godo.DomainRecordEditRequest
+	var skipped []zoneSkip
+
+	for _, l := range lines {
+		fields := l.fields
+
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) < 2 {
+				return nil, nil, fmt.Errorf("line %d: $ORIGIN requires a domain name", l.num)
+			}
+			origin = absoluteZoneName(fields[1], origin)
+			continue
+		case "$TTL":
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, nil, fmt.Errorf("line %d: %s is not supported", l.num, fields[0])
+		}
+
+		if !l.blankOwner {
+			owner, fields = fields[0], fields[1:]
+		}
+		if owner == "" {
+			return nil, nil, fmt.Errorf("line %d: record has no owner name", l.num)
+		}
+
+		fields = skipTTLAndClass(fields)
+		if len(fields) == 0 {
+			return nil, nil, fmt.Errorf("line %d: record has no type", l.num)
+		}
+
+		rType, rdata := strings.ToUpper(fields[0]), fields[1:]
+		name, err := relativeZoneName(absoluteZoneName(owner, origin), domain)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %v", l.num, err)
+		}
+
+		req, err := zoneRecordRequest(rType, name, rdata, origin)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %v", l.num, err)
+		}
+		if req == nil {
+			skipped = append(skipped, zoneSkip{Line: l.num, Name: name, Type: rType})
+			continue
+		}
+
+		reqs = append(reqs, req)
+	}
+
+	return reqs, skipped, nil
+}
+
+// zoneRecordRequest maps a resource record to an edit request. Unsupported
+// record types return a nil request.
+func zoneRecordRequest(rType, name string, rdata []string, origin string) (*godo.DomainRecordEditRequest, error) {
+	want := map[string]int{"A": 1, "AAAA": 1, "CNAME": 1, "NS": 1, "MX": 2, "SRV": 4}
+	if n, ok := want[rType]; ok && len(rdata) != n {
+		return nil, fmt.Errorf("%s record expects %d value(s), got %d", rType, n, len(rdata))
+	}
+
+	req := &godo.DomainRecordEditRequest{Type: rType, Name: name}
+
+	switch rType {
+	case "A", "AAAA":
+		req.Data = rdata[0]
+	case "CNAME", "NS":
+		req.Data = absoluteZoneName(rdata[0], origin)
+	case "MX":
+		priority, err := strconv.Atoi(rdata[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid MX priority %q", rdata[0])
+		}
+		req.Priority = priority
+		req.Data = absoluteZoneName(rdata[1], origin)
+	case "SRV":
+		var nums [3]int
+		for i := range nums {
+			n, err := strconv.Atoi(rdata[i])
+			if err != nil {
+				return nil, fmt.Errorf("invalid SRV value %q", rdata[i])
+			}
+			nums[i] = n
+		}
+		req.Priority, req.Weight, req.Port = nums[0], nums[1], nums[2]
+		req.Data = absoluteZoneName(rdata[3], origin)
+	case "TXT":
+		if len(rdata) == 0 {
+			return nil, fmt.Errorf("TXT record has no data")
+		}
+		var txt string
+		for _, s := range rdata {
+			txt += strings.Trim(s, `"`)
+		}
+		req.Data = txt
+	default:
+		return nil, nil
+	}
+
+	return req, nil
+}
+
+// skipTTLAndClass drops the optional TTL and class fields, which may appear
+// in either order before the record type.
+func skipTTLAndClass(fields []string) []string {
+	for i := 0; i < 2 && len(fields) > 0; i++ {
+		switch f := strings.ToUpper(fields[0]); {
+		case f == "IN" || f == "CH" || f == "HS":
+			fields = fields[1:]
+		case isZoneTTL(f):
+			fields = fields[1:]
+		}
+	}
+	return fields
+}
+
+func isZoneTTL(s string) bool {
+	if s == "" || !unicode.IsDigit(rune(s[0])) {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsDigit(r) && !strings.ContainsRune("SMHDW", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// absoluteZoneName expands a possibly relative name against origin.
+func absoluteZoneName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + origin
+	}
+}
+
+// relativeZoneName converts an absolute name to the form the API expects,
+// relative to domain with "@" for the apex.
+func relativeZoneName(name, domain string) (string, error) {
+	name = strings.TrimSuffix(name, ".")
+	if name == domain {
+		return "@", nil
+	}
+	if !strings.HasSuffix(name, "."+domain) {
+		return "", fmt.Errorf("name %q is outside of %s", name, domain)
+	}
+	return strings.TrimSuffix(name, "."+domain), nil
+}
+
+// readZoneLines splits a zone file into logical entries, removing comments
+// and joining lines wrapped in parentheses.
+func readZoneLines(r io.Reader) ([]zoneLine, error) {
+	var out []zoneLine
+	var cur *zoneLine
+	depth := 0
+
+	scanner := bufio.NewScanner(r)
+	num := 0
+	for scanner.Scan() {
+		num++
+		text := scanner.Text()
+
+		fields, open, err := splitZoneFields(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", num, err)
+		}
+
+		if depth == 0 {
+			if len(fields) == 0 {
+				continue
+			}
+			cur = &zoneLine{
+				num:        num,
+				blankOwner: text[0] == ' ' || text[0] == '\t',
+			}
+			out = append(out, *cur)
+			cur = &out[len(out)-1]
+		}
+
+		cur.fields = append(cur.fields, fields...)
+		depth += open
+		if depth < 0 {
+			return nil, fmt.Errorf("line %d: unbalanced parentheses", num)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", cur.num)
+	}
+
+	return out, nil
+}
+
+// splitZoneFields splits a physical line into fields, keeping quoted strings
+// intact. It returns the net number of parentheses opened on the line.
+func splitZoneFields(text string) ([]string, int, error) {
+	var fields []string
+	var field bytes.Buffer
+	inQuote, inField := false, false
+	open := 0
+
+	flush := func() {
+		if inField {
+			fields = append(fields, field.String())
+			field.Reset()
+			inField = false
+		}
+	}
+
+	for i := 0; i < len(text); i++ {
+		ch := text[i]
+		switch {
+		case inQuote:
+			field.WriteByte(ch)
+			if ch == '\\' && i+1 < len(text) {
+				i++
+				field.WriteByte(text[i])
+			} else if ch == '"' {
+				inQuote = false
+			}
+		case ch == '"':
+			inQuote, inField = true, true
+			field.WriteByte(ch)
+		case ch == ';':
+			flush()
+			return fields, open, nil
+		case ch == '(':
+			flush()
+			open++
+		case ch == ')':
+			flush()
+			open--
+		case ch == ' ' || ch == '\t':
+			flush()
+		default:
+			inField = true
+			field.WriteByte(ch)
+		}
+	}
+
+	if inQuote {
+		return nil, 0, fmt.Errorf("unterminated quoted string")
+	}
+	flush()
+
+	return fields, open, nil
+}
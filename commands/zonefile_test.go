@@ -0,0 +1,67 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	f, err := os.Open("../testdata/example.com.zone")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	reqs, skipped, err := parseZoneFile(f, "example.com")
+	assert.NoError(t, err)
+
+	expected := []*godo.DomainRecordEditRequest{
+		{Type: "NS", Name: "@", Data: "ns1.digitalocean.com."},
+		{Type: "A", Name: "@", Data: "192.0.2.1"},
+		{Type: "AAAA", Name: "@", Data: "2001:db8::1"},
+		{Type: "CNAME", Name: "www", Data: "example.com."},
+		{Type: "A", Name: "mail", Data: "192.0.2.2"},
+		{Type: "MX", Name: "@", Data: "mail.example.com.", Priority: 10},
+		{Type: "TXT", Name: "@", Data: "v=spf1 mx -all"},
+		{Type: "SRV", Name: "_sip._tcp", Data: "sip.example.com.", Priority: 10, Weight: 60, Port: 5060},
+	}
+	assert.Equal(t, expected, reqs)
+
+	assert.Equal(t, []zoneSkip{
+		{Line: 3, Name: "@", Type: "SOA"},
+		{Line: 17, Name: "@", Type: "CAA"},
+	}, skipped)
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	cases := map[string]string{
+		"www IN A 192.0.2.1\nother.org. IN A 192.0.2.2": `line 2: name "other.org" is outside of example.com`,
+		"@ IN MX mail":                    "line 1: MX record expects 2 value(s), got 1",
+		"@ IN SOA ns1. host. ( 1 2 3 4 5": "line 1: unbalanced parentheses",
+		"@ IN TXT \"unterminated":         "line 1: unterminated quoted string",
+		"$INCLUDE other.zone":             "line 1: $INCLUDE is not supported",
+		"_sip._tcp IN SRV 10 x 5060 sip.": `line 1: invalid SRV value "x"`,
+	}
+
+	for in, msg := range cases {
+		_, _, err := parseZoneFile(strings.NewReader(in), "example.com")
+		if assert.Error(t, err, in) {
+			assert.Equal(t, msg, err.Error(), in)
+		}
+	}
+}
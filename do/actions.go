@@ -26,7 +26,7 @@ type Actions []Action
 
 // ActionsService is an interface for interacting with DigitalOcean's action api.
 type ActionsService interface {
-	List() (Actions, error)
+	List(*godo.ListOptions) (Actions, error)
 	Get(int) (*Action, error)
 }
 
@@ -43,7 +43,7 @@ func NewActionsService(godoClient *godo.Client) ActionsService {
 	}
 }
 
-func (as *actionsService) List() (Actions, error) {
+func (as *actionsService) List(opt *godo.ListOptions) (Actions, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := as.client.Actions.List(opt)
 		if err != nil {
@@ -58,7 +58,7 @@ func (as *actionsService) List() (Actions, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -34,12 +34,12 @@ type DomainRecords []DomainRecord
 
 // DomainsService is the godo DOmainsService interface.
 type DomainsService interface {
-	List() (Domains, error)
+	List(*godo.ListOptions) (Domains, error)
 	Get(string) (*Domain, error)
 	Create(*godo.DomainCreateRequest) (*Domain, error)
 	Delete(string) error
 
-	Records(string) (DomainRecords, error)
+	Records(string, *godo.ListOptions) (DomainRecords, error)
 	Record(string, int) (*DomainRecord, error)
 	DeleteRecord(string, int) error
 	EditRecord(string, int, *godo.DomainRecordEditRequest) (*DomainRecord, error)
@@ -59,7 +59,7 @@ func NewDomainsService(client *godo.Client) DomainsService {
 	}
 }
 
-func (ds *domainsService) List() (Domains, error) {
+func (ds *domainsService) List(opt *godo.ListOptions) (Domains, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := ds.client.Domains.List(opt)
 		if err != nil {
@@ -74,7 +74,7 @@ func (ds *domainsService) List() (Domains, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +111,7 @@ func (ds *domainsService) Delete(name string) error {
 	return err
 }
 
-func (ds *domainsService) Records(name string) (DomainRecords, error) {
+func (ds *domainsService) Records(name string, opt *godo.ListOptions) (DomainRecords, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := ds.client.Domains.Records(name, opt)
 		if err != nil {
@@ -126,7 +126,7 @@ func (ds *domainsService) Records(name string) (DomainRecords, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -14,27 +14,41 @@ limitations under the License.
 
 package do
 
-import "github.com/digitalocean/godo"
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/digitalocean/godo"
+)
 
 // DropletActionsService is an interface for interacting with DigitalOcean's droplet action api.
 type DropletActionsService interface {
 	Shutdown(int) (*Action, error)
+	ShutdownByTag(string) (Actions, error)
 	PowerOff(int) (*Action, error)
+	PowerOffByTag(string) (Actions, error)
 	PowerOn(int) (*Action, error)
+	PowerOnByTag(string) (Actions, error)
 	PowerCycle(int) (*Action, error)
+	PowerCycleByTag(string) (Actions, error)
 	Reboot(int) (*Action, error)
 	Restore(int, int) (*Action, error)
 	Resize(int, string, bool) (*Action, error)
 	Rename(int, string) (*Action, error)
 	Snapshot(int, string) (*Action, error)
+	SnapshotByTag(string, string) (Actions, error)
 	EnableBackups(int) (*Action, error)
+	EnableBackupsByTag(string) (Actions, error)
 	DisableBackups(int) (*Action, error)
+	DisableBackupsByTag(string) (Actions, error)
 	PasswordReset(int) (*Action, error)
 	RebuildByImageID(int, int) (*Action, error)
 	RebuildByImageSlug(int, string) (*Action, error)
 	ChangeKernel(int, int) (*Action, error)
 	EnableIPv6(int) (*Action, error)
+	EnableIPv6ByTag(string) (Actions, error)
 	EnablePrivateNetworking(int) (*Action, error)
+	EnablePrivateNetworkingByTag(string) (Actions, error)
 	Upgrade(int) (*Action, error)
 	Get(int, int) (*Action, error)
 	GetByURI(string) (*Action, error)
@@ -61,26 +75,68 @@ func (das *dropletActionsService) handleActionResponse(a *godo.Action, err error
 	return &Action{Action: a}, nil
 }
 
+// doActionByTag performs an action on all droplets with a tag. The tag
+// scoped endpoint responds with one action per droplet, so the request is
+// made directly rather than through godo which only decodes a single action.
+func (das *dropletActionsService) doActionByTag(tag string, request *godo.ActionRequest) (Actions, error) {
+	path := fmt.Sprintf("v2/droplets/actions?tag_name=%s", url.QueryEscape(tag))
+
+	req, err := das.client.NewRequest("POST", path, request)
+	if err != nil {
+		return nil, err
+	}
+
+	root := struct {
+		Actions []godo.Action `json:"actions"`
+	}{}
+	if _, err := das.client.Do(req, &root); err != nil {
+		return nil, err
+	}
+
+	actions := make(Actions, len(root.Actions))
+	for i := range root.Actions {
+		actions[i] = Action{Action: &root.Actions[i]}
+	}
+
+	return actions, nil
+}
+
 func (das *dropletActionsService) Shutdown(id int) (*Action, error) {
 	a, _, err := das.client.DropletActions.Shutdown(id)
 	return das.handleActionResponse(a, err)
 }
 
+func (das *dropletActionsService) ShutdownByTag(tag string) (Actions, error) {
+	return das.doActionByTag(tag, &godo.ActionRequest{"type": "shutdown"})
+}
+
 func (das *dropletActionsService) PowerOff(id int) (*Action, error) {
 	a, _, err := das.client.DropletActions.PowerOff(id)
 	return das.handleActionResponse(a, err)
 }
 
+func (das *dropletActionsService) PowerOffByTag(tag string) (Actions, error) {
+	return das.doActionByTag(tag, &godo.ActionRequest{"type": "power_off"})
+}
+
 func (das *dropletActionsService) PowerOn(id int) (*Action, error) {
 	a, _, err := das.client.DropletActions.PowerOn(id)
 	return das.handleActionResponse(a, err)
 }
 
+func (das *dropletActionsService) PowerOnByTag(tag string) (Actions, error) {
+	return das.doActionByTag(tag, &godo.ActionRequest{"type": "power_on"})
+}
+
 func (das *dropletActionsService) PowerCycle(id int) (*Action, error) {
 	a, _, err := das.client.DropletActions.PowerCycle(id)
 	return das.handleActionResponse(a, err)
 }
 
+func (das *dropletActionsService) PowerCycleByTag(tag string) (Actions, error) {
+	return das.doActionByTag(tag, &godo.ActionRequest{"type": "power_cycle"})
+}
+
 func (das *dropletActionsService) Reboot(id int) (*Action, error) {
 	a, _, err := das.client.DropletActions.Reboot(id)
 	return das.handleActionResponse(a, err)
@@ -106,16 +162,28 @@ func (das *dropletActionsService) Snapshot(id int, name string) (*Action, error)
 	return das.handleActionResponse(a, err)
 }
 
+func (das *dropletActionsService) SnapshotByTag(tag string, name string) (Actions, error) {
+	return das.doActionByTag(tag, &godo.ActionRequest{"type": "snapshot", "name": name})
+}
+
 func (das *dropletActionsService) EnableBackups(id int) (*Action, error) {
 	a, _, err := das.client.DropletActions.EnableBackups(id)
 	return das.handleActionResponse(a, err)
 }
 
+func (das *dropletActionsService) EnableBackupsByTag(tag string) (Actions, error) {
+	return das.doActionByTag(tag, &godo.ActionRequest{"type": "enable_backups"})
+}
+
 func (das *dropletActionsService) DisableBackups(id int) (*Action, error) {
 	a, _, err := das.client.DropletActions.DisableBackups(id)
 	return das.handleActionResponse(a, err)
 }
 
+func (das *dropletActionsService) DisableBackupsByTag(tag string) (Actions, error) {
+	return das.doActionByTag(tag, &godo.ActionRequest{"type": "disable_backups"})
+}
+
 func (das *dropletActionsService) PasswordReset(id int) (*Action, error) {
 	a, _, err := das.client.DropletActions.PasswordReset(id)
 	return das.handleActionResponse(a, err)
@@ -141,11 +209,19 @@ func (das *dropletActionsService) EnableIPv6(id int) (*Action, error) {
 	return das.handleActionResponse(a, err)
 }
 
+func (das *dropletActionsService) EnableIPv6ByTag(tag string) (Actions, error) {
+	return das.doActionByTag(tag, &godo.ActionRequest{"type": "enable_ipv6"})
+}
+
 func (das *dropletActionsService) EnablePrivateNetworking(id int) (*Action, error) {
 	a, _, err := das.client.DropletActions.EnablePrivateNetworking(id)
 	return das.handleActionResponse(a, err)
 }
 
+func (das *dropletActionsService) EnablePrivateNetworkingByTag(tag string) (Actions, error) {
+	return das.doActionByTag(tag, &godo.ActionRequest{"type": "enable_private_networking"})
+}
+
 func (das *dropletActionsService) Upgrade(id int) (*Action, error) {
 	a, _, err := das.client.DropletActions.Upgrade(id)
 	return das.handleActionResponse(a, err)
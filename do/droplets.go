@@ -13,10 +13,7 @@ limitations under the License.
 
 package do
 
-import (
-	"github.com/digitalocean/godo"
-	"github.com/digitalocean/godo/util"
-)
+import "github.com/digitalocean/godo"
 
 // DropletIPTable is a table of interface IPS.
 type DropletIPTable map[InterfaceType]string
@@ -49,18 +46,19 @@ type Kernels []Kernel
 
 // DropletsService is an interface for interacting with DigitalOcean's droplet api.
 type DropletsService interface {
-	List() (Droplets, error)
-	ListByTag(string) (Droplets, error)
+	List(*godo.ListOptions) (Droplets, error)
+	ListByTag(string, *godo.ListOptions) (Droplets, error)
 	Get(int) (*Droplet, error)
-	Create(*godo.DropletCreateRequest, bool) (*Droplet, error)
+	Create(*godo.DropletCreateRequest) (*Droplet, error)
 	CreateMultiple(*godo.DropletMultiCreateRequest) (Droplets, error)
 	Delete(int) error
 	DeleteByTag(string) error
-	Kernels(int) (Kernels, error)
-	Snapshots(int) (Images, error)
-	Backups(int) (Images, error)
-	Actions(int) (Actions, error)
+	Kernels(int, *godo.ListOptions) (Kernels, error)
+	Snapshots(int, *godo.ListOptions) (Images, error)
+	Backups(int, *godo.ListOptions) (Images, error)
+	Actions(int, *godo.ListOptions) (Actions, error)
 	Neighbors(int) (Droplets, error)
+	AllNeighbors() ([]Droplets, error)
 }
 
 type dropletsService struct {
@@ -76,7 +74,7 @@ func NewDropletsService(client *godo.Client) DropletsService {
 	}
 }
 
-func (ds *dropletsService) List() (Droplets, error) {
+func (ds *dropletsService) List(opt *godo.ListOptions) (Droplets, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := ds.client.Droplets.List(opt)
 		if err != nil {
@@ -91,7 +89,7 @@ func (ds *dropletsService) List() (Droplets, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +103,7 @@ func (ds *dropletsService) List() (Droplets, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) ListByTag(tagName string) (Droplets, error) {
+func (ds *dropletsService) ListByTag(tagName string, opt *godo.ListOptions) (Droplets, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := ds.client.Droplets.ListByTag(tagName, opt)
 		if err != nil {
@@ -120,7 +118,7 @@ func (ds *dropletsService) ListByTag(tagName string) (Droplets, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -143,31 +141,12 @@ func (ds *dropletsService) Get(id int) (*Droplet, error) {
 	return &Droplet{Droplet: d}, nil
 }
 
-func (ds *dropletsService) Create(dcr *godo.DropletCreateRequest, wait bool) (*Droplet, error) {
-	d, resp, err := ds.client.Droplets.Create(dcr)
+func (ds *dropletsService) Create(dcr *godo.DropletCreateRequest) (*Droplet, error) {
+	d, _, err := ds.client.Droplets.Create(dcr)
 	if err != nil {
 		return nil, err
 	}
 
-	if wait {
-		var action *godo.LinkAction
-		for _, a := range resp.Links.Actions {
-			if a.Rel == "create" {
-				action = &a
-				break
-			}
-		}
-
-		if action != nil {
-			_ = util.WaitForActive(ds.client, action.HREF)
-			doDroplet, err := ds.Get(d.ID)
-			if err != nil {
-				return nil, err
-			}
-			d = doDroplet.Droplet
-		}
-	}
-
 	return &Droplet{Droplet: d}, nil
 }
 
@@ -195,7 +174,7 @@ func (ds *dropletsService) DeleteByTag(tag string) error {
 	return err
 }
 
-func (ds *dropletsService) Kernels(id int) (Kernels, error) {
+func (ds *dropletsService) Kernels(id int, opt *godo.ListOptions) (Kernels, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := ds.client.Droplets.Kernels(id, opt)
 		if err != nil {
@@ -210,7 +189,7 @@ func (ds *dropletsService) Kernels(id int) (Kernels, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +203,7 @@ func (ds *dropletsService) Kernels(id int) (Kernels, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Snapshots(id int) (Images, error) {
+func (ds *dropletsService) Snapshots(id int, opt *godo.ListOptions) (Images, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := ds.client.Droplets.Snapshots(id, opt)
 		if err != nil {
@@ -239,7 +218,7 @@ func (ds *dropletsService) Snapshots(id int) (Images, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -253,7 +232,7 @@ func (ds *dropletsService) Snapshots(id int) (Images, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Backups(id int) (Images, error) {
+func (ds *dropletsService) Backups(id int, opt *godo.ListOptions) (Images, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := ds.client.Droplets.Backups(id, opt)
 		if err != nil {
@@ -268,7 +247,7 @@ func (ds *dropletsService) Backups(id int) (Images, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -282,7 +261,7 @@ func (ds *dropletsService) Backups(id int) (Images, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Actions(id int) (Actions, error) {
+func (ds *dropletsService) Actions(id int, opt *godo.ListOptions) (Actions, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := ds.client.Droplets.Actions(id, opt)
 		if err != nil {
@@ -297,7 +276,7 @@ func (ds *dropletsService) Actions(id int) (Actions, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -318,9 +297,36 @@ func (ds *dropletsService) Neighbors(id int) (Droplets, error) {
 	}
 
 	var droplets Droplets
-	for _, d := range list {
-		droplets = append(droplets, Droplet{Droplet: &d})
+	for i := range list {
+		droplets = append(droplets, Droplet{Droplet: &list[i]})
 	}
 
 	return droplets, nil
 }
+
+type dropletNeighborsRoot struct {
+	Neighbors [][]godo.Droplet `json:"neighbors"`
+}
+
+// AllNeighbors returns every group of droplets that share physical hardware.
+// godo has no call for the neighbors report, so the request is made directly.
+func (ds *dropletsService) AllNeighbors() ([]Droplets, error) {
+	req, err := ds.client.NewRequest("GET", "v2/reports/droplet_neighbors", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(dropletNeighborsRoot)
+	if _, err := ds.client.Do(req, root); err != nil {
+		return nil, err
+	}
+
+	groups := make([]Droplets, len(root.Neighbors))
+	for i, group := range root.Neighbors {
+		for j := range group {
+			groups[i] = append(groups[i], Droplet{Droplet: &group[j]})
+		}
+	}
+
+	return groups, nil
+}
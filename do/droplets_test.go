@@ -0,0 +1,81 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package do
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"testing"
+
+	"github.com/bryanl/godomock"
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDropletsServiceListByTagPaginates(t *testing.T) {
+	gDropletsSvc := &godomock.MockDropletsService{}
+
+	resp := &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Last: "http://example.com/?page=3"}}}
+	for page := 1; page <= 3; page++ {
+		page := page
+		gDropletsSvc.On("ListByTag", "web", mock.MatchedBy(func(opt *godo.ListOptions) bool {
+			return opt.Page == page
+		})).Return([]godo.Droplet{{ID: page}}, resp, nil)
+	}
+
+	client := &godo.Client{
+		Droplets: gDropletsSvc,
+	}
+	ds := NewDropletsService(client)
+
+	list, err := ds.ListByTag("web", nil)
+	assert.NoError(t, err)
+
+	var ids []int
+	for _, d := range list {
+		ids = append(ids, d.ID)
+	}
+	sort.Ints(ids)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestDropletsServiceAllNeighbors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/reports/droplet_neighbors", r.URL.Path)
+		fmt.Fprint(w, `{"neighbors": [[{"id": 1}, {"id": 2}], [{"id": 3}]]}`)
+	}))
+	defer server.Close()
+
+	client := godo.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	ds := NewDropletsService(client)
+
+	groups, err := ds.AllNeighbors()
+	assert.NoError(t, err)
+
+	var ids [][]int
+	for _, g := range groups {
+		var group []int
+		for _, d := range g {
+			group = append(group, d.ID)
+		}
+		ids = append(ids, group)
+	}
+	assert.Equal(t, [][]int{{1, 2}, {3}}, ids)
+}
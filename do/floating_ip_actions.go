@@ -80,7 +80,7 @@ func (fia *floatingIPActionsService) List(ip string, opt *godo.ListOptions) ([]A
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
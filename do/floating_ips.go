@@ -26,7 +26,7 @@ type FloatingIPs []FloatingIP
 
 // FloatingIPsService is the godo FloatingIPsService interface.
 type FloatingIPsService interface {
-	List() (FloatingIPs, error)
+	List(*godo.ListOptions) (FloatingIPs, error)
 	Get(ip string) (*FloatingIP, error)
 	Create(ficr *godo.FloatingIPCreateRequest) (*FloatingIP, error)
 	Delete(ip string) error
@@ -45,7 +45,7 @@ func NewFloatingIPsService(client *godo.Client) FloatingIPsService {
 	}
 }
 
-func (fis *floatingIPsService) List() (FloatingIPs, error) {
+func (fis *floatingIPsService) List(opt *godo.ListOptions) (FloatingIPs, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := fis.client.FloatingIPs.List(opt)
 		if err != nil {
@@ -60,7 +60,7 @@ func (fis *floatingIPsService) List() (FloatingIPs, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
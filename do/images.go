@@ -26,10 +26,10 @@ type Images []Image
 
 // ImagesService is the godo ImagesService interface.
 type ImagesService interface {
-	List(public bool) (Images, error)
-	ListDistribution(public bool) (Images, error)
-	ListApplication(public bool) (Images, error)
-	ListUser(public bool) (Images, error)
+	List(public bool, opt *godo.ListOptions) (Images, error)
+	ListDistribution(public bool, opt *godo.ListOptions) (Images, error)
+	ListApplication(public bool, opt *godo.ListOptions) (Images, error)
+	ListUser(public bool, opt *godo.ListOptions) (Images, error)
 	GetByID(id int) (*Image, error)
 	GetBySlug(slug string) (*Image, error)
 	Update(id int, iur *godo.ImageUpdateRequest) (*Image, error)
@@ -49,20 +49,20 @@ func NewImagesService(client *godo.Client) ImagesService {
 	}
 }
 
-func (is *imagesService) List(public bool) (Images, error) {
-	return is.listImages(is.client.Images.List, public)
+func (is *imagesService) List(public bool, opt *godo.ListOptions) (Images, error) {
+	return is.listImages(is.client.Images.List, public, opt)
 }
 
-func (is *imagesService) ListDistribution(public bool) (Images, error) {
-	return is.listImages(is.client.Images.ListDistribution, public)
+func (is *imagesService) ListDistribution(public bool, opt *godo.ListOptions) (Images, error) {
+	return is.listImages(is.client.Images.ListDistribution, public, opt)
 }
 
-func (is *imagesService) ListApplication(public bool) (Images, error) {
-	return is.listImages(is.client.Images.ListApplication, public)
+func (is *imagesService) ListApplication(public bool, opt *godo.ListOptions) (Images, error) {
+	return is.listImages(is.client.Images.ListApplication, public, opt)
 }
 
-func (is *imagesService) ListUser(public bool) (Images, error) {
-	return is.listImages(is.client.Images.ListUser, public)
+func (is *imagesService) ListUser(public bool, opt *godo.ListOptions) (Images, error) {
+	return is.listImages(is.client.Images.ListUser, public, opt)
 }
 
 func (is *imagesService) GetByID(id int) (*Image, error) {
@@ -99,7 +99,7 @@ func (is *imagesService) Delete(id int) error {
 
 type listFn func(*godo.ListOptions) ([]godo.Image, *godo.Response, error)
 
-func (is *imagesService) listImages(lFn listFn, public bool) (Images, error) {
+func (is *imagesService) listImages(lFn listFn, public bool, opt *godo.ListOptions) (Images, error) {
 	fn := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := lFn(opt)
 		if err != nil {
@@ -116,7 +116,7 @@ func (is *imagesService) listImages(lFn listFn, public bool) (Images, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(fn)
+	si, err := PaginateResp(fn, opt)
 	if err != nil {
 		return nil, err
 	}
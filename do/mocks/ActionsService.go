@@ -3,6 +3,8 @@ package mocks
 import "github.com/digitalocean/doctl/do"
 import "github.com/stretchr/testify/mock"
 
+import "github.com/digitalocean/godo"
+
 // Generated: please do not edit by hand
 
 // ActionsService is an autogenerated mock type for the ActionsService type
@@ -33,13 +35,13 @@ func (_m *ActionsService) Get(_a0 int) (*do.Action, error) {
 	return r0, r1
 }
 
-// List provides a mock function with given fields:
-func (_m *ActionsService) List() (do.Actions, error) {
-	ret := _m.Called()
+// List provides a mock function with given fields: _a0
+func (_m *ActionsService) List(_a0 *godo.ListOptions) (do.Actions, error) {
+	ret := _m.Called(_a0)
 
 	var r0 do.Actions
-	if rf, ok := ret.Get(0).(func() do.Actions); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*godo.ListOptions) do.Actions); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Actions)
@@ -47,8 +49,8 @@ func (_m *ActionsService) List() (do.Actions, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*godo.ListOptions) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -132,13 +132,13 @@ func (_m *DomainsService) Get(_a0 string) (*do.Domain, error) {
 	return r0, r1
 }
 
-// List provides a mock function with given fields:
-func (_m *DomainsService) List() (do.Domains, error) {
-	ret := _m.Called()
+// List provides a mock function with given fields: _a0
+func (_m *DomainsService) List(_a0 *godo.ListOptions) (do.Domains, error) {
+	ret := _m.Called(_a0)
 
 	var r0 do.Domains
-	if rf, ok := ret.Get(0).(func() do.Domains); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*godo.ListOptions) do.Domains); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Domains)
@@ -146,8 +146,8 @@ func (_m *DomainsService) List() (do.Domains, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*godo.ListOptions) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -178,13 +178,13 @@ func (_m *DomainsService) Record(_a0 string, _a1 int) (*do.DomainRecord, error)
 	return r0, r1
 }
 
-// Records provides a mock function with given fields: _a0
-func (_m *DomainsService) Records(_a0 string) (do.DomainRecords, error) {
-	ret := _m.Called(_a0)
+// Records provides a mock function with given fields: _a0, _a1
+func (_m *DomainsService) Records(_a0 string, _a1 *godo.ListOptions) (do.DomainRecords, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 do.DomainRecords
-	if rf, ok := ret.Get(0).(func(string) do.DomainRecords); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(string, *godo.ListOptions) do.DomainRecords); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.DomainRecords)
@@ -192,8 +192,8 @@ func (_m *DomainsService) Records(_a0 string) (do.DomainRecords, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(string, *godo.ListOptions) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
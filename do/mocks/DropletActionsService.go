@@ -56,6 +56,29 @@ func (_m *DropletActionsService) DisableBackups(_a0 int) (*do.Action, error) {
 	return r0, r1
 }
 
+// DisableBackupsByTag provides a mock function with given fields: _a0
+func (_m *DropletActionsService) DisableBackupsByTag(_a0 string) (do.Actions, error) {
+	ret := _m.Called(_a0)
+
+	var r0 do.Actions
+	if rf, ok := ret.Get(0).(func(string) do.Actions); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(do.Actions)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EnableBackups provides a mock function with given fields: _a0
 func (_m *DropletActionsService) EnableBackups(_a0 int) (*do.Action, error) {
 	ret := _m.Called(_a0)
@@ -79,6 +102,29 @@ func (_m *DropletActionsService) EnableBackups(_a0 int) (*do.Action, error) {
 	return r0, r1
 }
 
+// EnableBackupsByTag provides a mock function with given fields: _a0
+func (_m *DropletActionsService) EnableBackupsByTag(_a0 string) (do.Actions, error) {
+	ret := _m.Called(_a0)
+
+	var r0 do.Actions
+	if rf, ok := ret.Get(0).(func(string) do.Actions); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(do.Actions)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EnableIPv6 provides a mock function with given fields: _a0
 func (_m *DropletActionsService) EnableIPv6(_a0 int) (*do.Action, error) {
 	ret := _m.Called(_a0)
@@ -102,6 +148,29 @@ func (_m *DropletActionsService) EnableIPv6(_a0 int) (*do.Action, error) {
 	return r0, r1
 }
 
+// EnableIPv6ByTag provides a mock function with given fields: _a0
+func (_m *DropletActionsService) EnableIPv6ByTag(_a0 string) (do.Actions, error) {
+	ret := _m.Called(_a0)
+
+	var r0 do.Actions
+	if rf, ok := ret.Get(0).(func(string) do.Actions); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(do.Actions)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EnablePrivateNetworking provides a mock function with given fields: _a0
 func (_m *DropletActionsService) EnablePrivateNetworking(_a0 int) (*do.Action, error) {
 	ret := _m.Called(_a0)
@@ -125,6 +194,29 @@ func (_m *DropletActionsService) EnablePrivateNetworking(_a0 int) (*do.Action, e
 	return r0, r1
 }
 
+// EnablePrivateNetworkingByTag provides a mock function with given fields: _a0
+func (_m *DropletActionsService) EnablePrivateNetworkingByTag(_a0 string) (do.Actions, error) {
+	ret := _m.Called(_a0)
+
+	var r0 do.Actions
+	if rf, ok := ret.Get(0).(func(string) do.Actions); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(do.Actions)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: _a0, _a1
 func (_m *DropletActionsService) Get(_a0 int, _a1 int) (*do.Action, error) {
 	ret := _m.Called(_a0, _a1)
@@ -217,6 +309,29 @@ func (_m *DropletActionsService) PowerCycle(_a0 int) (*do.Action, error) {
 	return r0, r1
 }
 
+// PowerCycleByTag provides a mock function with given fields: _a0
+func (_m *DropletActionsService) PowerCycleByTag(_a0 string) (do.Actions, error) {
+	ret := _m.Called(_a0)
+
+	var r0 do.Actions
+	if rf, ok := ret.Get(0).(func(string) do.Actions); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(do.Actions)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PowerOff provides a mock function with given fields: _a0
 func (_m *DropletActionsService) PowerOff(_a0 int) (*do.Action, error) {
 	ret := _m.Called(_a0)
@@ -240,6 +355,29 @@ func (_m *DropletActionsService) PowerOff(_a0 int) (*do.Action, error) {
 	return r0, r1
 }
 
+// PowerOffByTag provides a mock function with given fields: _a0
+func (_m *DropletActionsService) PowerOffByTag(_a0 string) (do.Actions, error) {
+	ret := _m.Called(_a0)
+
+	var r0 do.Actions
+	if rf, ok := ret.Get(0).(func(string) do.Actions); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(do.Actions)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PowerOn provides a mock function with given fields: _a0
 func (_m *DropletActionsService) PowerOn(_a0 int) (*do.Action, error) {
 	ret := _m.Called(_a0)
@@ -263,6 +401,29 @@ func (_m *DropletActionsService) PowerOn(_a0 int) (*do.Action, error) {
 	return r0, r1
 }
 
+// PowerOnByTag provides a mock function with given fields: _a0
+func (_m *DropletActionsService) PowerOnByTag(_a0 string) (do.Actions, error) {
+	ret := _m.Called(_a0)
+
+	var r0 do.Actions
+	if rf, ok := ret.Get(0).(func(string) do.Actions); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(do.Actions)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Reboot provides a mock function with given fields: _a0
 func (_m *DropletActionsService) Reboot(_a0 int) (*do.Action, error) {
 	ret := _m.Called(_a0)
@@ -424,6 +585,29 @@ func (_m *DropletActionsService) Shutdown(_a0 int) (*do.Action, error) {
 	return r0, r1
 }
 
+// ShutdownByTag provides a mock function with given fields: _a0
+func (_m *DropletActionsService) ShutdownByTag(_a0 string) (do.Actions, error) {
+	ret := _m.Called(_a0)
+
+	var r0 do.Actions
+	if rf, ok := ret.Get(0).(func(string) do.Actions); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(do.Actions)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Snapshot provides a mock function with given fields: _a0, _a1
 func (_m *DropletActionsService) Snapshot(_a0 int, _a1 string) (*do.Action, error) {
 	ret := _m.Called(_a0, _a1)
@@ -447,6 +631,29 @@ func (_m *DropletActionsService) Snapshot(_a0 int, _a1 string) (*do.Action, erro
 	return r0, r1
 }
 
+// SnapshotByTag provides a mock function with given fields: _a0, _a1
+func (_m *DropletActionsService) SnapshotByTag(_a0 string, _a1 string) (do.Actions, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 do.Actions
+	if rf, ok := ret.Get(0).(func(string, string) do.Actions); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(do.Actions)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Upgrade provides a mock function with given fields: _a0
 func (_m *DropletActionsService) Upgrade(_a0 int) (*do.Action, error) {
 	ret := _m.Called(_a0)
@@ -12,13 +12,13 @@ type DropletsService struct {
 	mock.Mock
 }
 
-// Actions provides a mock function with given fields: _a0
-func (_m *DropletsService) Actions(_a0 int) (do.Actions, error) {
-	ret := _m.Called(_a0)
+// Actions provides a mock function with given fields: _a0, _a1
+func (_m *DropletsService) Actions(_a0 int, _a1 *godo.ListOptions) (do.Actions, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 do.Actions
-	if rf, ok := ret.Get(0).(func(int) do.Actions); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(int, *godo.ListOptions) do.Actions); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Actions)
@@ -26,8 +26,8 @@ func (_m *DropletsService) Actions(_a0 int) (do.Actions, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(int, *godo.ListOptions) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +35,36 @@ func (_m *DropletsService) Actions(_a0 int) (do.Actions, error) {
 	return r0, r1
 }
 
-// Backups provides a mock function with given fields: _a0
-func (_m *DropletsService) Backups(_a0 int) (do.Images, error) {
-	ret := _m.Called(_a0)
+// AllNeighbors provides a mock function with given fields:
+func (_m *DropletsService) AllNeighbors() ([]do.Droplets, error) {
+	ret := _m.Called()
+
+	var r0 []do.Droplets
+	if rf, ok := ret.Get(0).(func() []do.Droplets); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]do.Droplets)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backups provides a mock function with given fields: _a0, _a1
+func (_m *DropletsService) Backups(_a0 int, _a1 *godo.ListOptions) (do.Images, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 do.Images
-	if rf, ok := ret.Get(0).(func(int) do.Images); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(int, *godo.ListOptions) do.Images); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Images)
@@ -49,8 +72,8 @@ func (_m *DropletsService) Backups(_a0 int) (do.Images, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(int, *godo.ListOptions) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +81,13 @@ func (_m *DropletsService) Backups(_a0 int) (do.Images, error) {
 	return r0, r1
 }
 
-// Create provides a mock function with given fields: _a0, _a1
-func (_m *DropletsService) Create(_a0 *godo.DropletCreateRequest, _a1 bool) (*do.Droplet, error) {
-	ret := _m.Called(_a0, _a1)
+// Create provides a mock function with given fields: _a0
+func (_m *DropletsService) Create(_a0 *godo.DropletCreateRequest) (*do.Droplet, error) {
+	ret := _m.Called(_a0)
 
 	var r0 *do.Droplet
-	if rf, ok := ret.Get(0).(func(*godo.DropletCreateRequest, bool) *do.Droplet); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(*godo.DropletCreateRequest) *do.Droplet); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*do.Droplet)
@@ -72,8 +95,8 @@ func (_m *DropletsService) Create(_a0 *godo.DropletCreateRequest, _a1 bool) (*do
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*godo.DropletCreateRequest, bool) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(*godo.DropletCreateRequest) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -155,13 +178,13 @@ func (_m *DropletsService) Get(_a0 int) (*do.Droplet, error) {
 	return r0, r1
 }
 
-// Kernels provides a mock function with given fields: _a0
-func (_m *DropletsService) Kernels(_a0 int) (do.Kernels, error) {
-	ret := _m.Called(_a0)
+// Kernels provides a mock function with given fields: _a0, _a1
+func (_m *DropletsService) Kernels(_a0 int, _a1 *godo.ListOptions) (do.Kernels, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 do.Kernels
-	if rf, ok := ret.Get(0).(func(int) do.Kernels); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(int, *godo.ListOptions) do.Kernels); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Kernels)
@@ -169,8 +192,8 @@ func (_m *DropletsService) Kernels(_a0 int) (do.Kernels, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(int, *godo.ListOptions) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -178,13 +201,13 @@ func (_m *DropletsService) Kernels(_a0 int) (do.Kernels, error) {
 	return r0, r1
 }
 
-// List provides a mock function with given fields:
-func (_m *DropletsService) List() (do.Droplets, error) {
-	ret := _m.Called()
+// List provides a mock function with given fields: _a0
+func (_m *DropletsService) List(_a0 *godo.ListOptions) (do.Droplets, error) {
+	ret := _m.Called(_a0)
 
 	var r0 do.Droplets
-	if rf, ok := ret.Get(0).(func() do.Droplets); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*godo.ListOptions) do.Droplets); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Droplets)
@@ -192,8 +215,8 @@ func (_m *DropletsService) List() (do.Droplets, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*godo.ListOptions) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -201,13 +224,13 @@ func (_m *DropletsService) List() (do.Droplets, error) {
 	return r0, r1
 }
 
-// ListByTag provides a mock function with given fields: _a0
-func (_m *DropletsService) ListByTag(_a0 string) (do.Droplets, error) {
-	ret := _m.Called(_a0)
+// ListByTag provides a mock function with given fields: _a0, _a1
+func (_m *DropletsService) ListByTag(_a0 string, _a1 *godo.ListOptions) (do.Droplets, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 do.Droplets
-	if rf, ok := ret.Get(0).(func(string) do.Droplets); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(string, *godo.ListOptions) do.Droplets); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Droplets)
@@ -215,8 +238,8 @@ func (_m *DropletsService) ListByTag(_a0 string) (do.Droplets, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(string, *godo.ListOptions) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -247,13 +270,13 @@ func (_m *DropletsService) Neighbors(_a0 int) (do.Droplets, error) {
 	return r0, r1
 }
 
-// Snapshots provides a mock function with given fields: _a0
-func (_m *DropletsService) Snapshots(_a0 int) (do.Images, error) {
-	ret := _m.Called(_a0)
+// Snapshots provides a mock function with given fields: _a0, _a1
+func (_m *DropletsService) Snapshots(_a0 int, _a1 *godo.ListOptions) (do.Images, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 do.Images
-	if rf, ok := ret.Get(0).(func(int) do.Images); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(int, *godo.ListOptions) do.Images); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Images)
@@ -261,8 +284,8 @@ func (_m *DropletsService) Snapshots(_a0 int) (do.Images, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(int, *godo.ListOptions) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,13 +72,13 @@ func (_m *FloatingIPsService) Get(ip string) (*do.FloatingIP, error) {
 	return r0, r1
 }
 
-// List provides a mock function with given fields:
-func (_m *FloatingIPsService) List() (do.FloatingIPs, error) {
-	ret := _m.Called()
+// List provides a mock function with given fields: _a0
+func (_m *FloatingIPsService) List(_a0 *godo.ListOptions) (do.FloatingIPs, error) {
+	ret := _m.Called(_a0)
 
 	var r0 do.FloatingIPs
-	if rf, ok := ret.Get(0).(func() do.FloatingIPs); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*godo.ListOptions) do.FloatingIPs); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.FloatingIPs)
@@ -86,8 +86,8 @@ func (_m *FloatingIPsService) List() (do.FloatingIPs, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*godo.ListOptions) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,13 +72,13 @@ func (_m *ImagesService) GetBySlug(slug string) (*do.Image, error) {
 	return r0, r1
 }
 
-// List provides a mock function with given fields: public
-func (_m *ImagesService) List(public bool) (do.Images, error) {
-	ret := _m.Called(public)
+// List provides a mock function with given fields: public, opt
+func (_m *ImagesService) List(public bool, opt *godo.ListOptions) (do.Images, error) {
+	ret := _m.Called(public, opt)
 
 	var r0 do.Images
-	if rf, ok := ret.Get(0).(func(bool) do.Images); ok {
-		r0 = rf(public)
+	if rf, ok := ret.Get(0).(func(bool, *godo.ListOptions) do.Images); ok {
+		r0 = rf(public, opt)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Images)
@@ -86,8 +86,8 @@ func (_m *ImagesService) List(public bool) (do.Images, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(bool) error); ok {
-		r1 = rf(public)
+	if rf, ok := ret.Get(1).(func(bool, *godo.ListOptions) error); ok {
+		r1 = rf(public, opt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -95,13 +95,13 @@ func (_m *ImagesService) List(public bool) (do.Images, error) {
 	return r0, r1
 }
 
-// ListApplication provides a mock function with given fields: public
-func (_m *ImagesService) ListApplication(public bool) (do.Images, error) {
-	ret := _m.Called(public)
+// ListApplication provides a mock function with given fields: public, opt
+func (_m *ImagesService) ListApplication(public bool, opt *godo.ListOptions) (do.Images, error) {
+	ret := _m.Called(public, opt)
 
 	var r0 do.Images
-	if rf, ok := ret.Get(0).(func(bool) do.Images); ok {
-		r0 = rf(public)
+	if rf, ok := ret.Get(0).(func(bool, *godo.ListOptions) do.Images); ok {
+		r0 = rf(public, opt)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Images)
@@ -109,8 +109,8 @@ func (_m *ImagesService) ListApplication(public bool) (do.Images, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(bool) error); ok {
-		r1 = rf(public)
+	if rf, ok := ret.Get(1).(func(bool, *godo.ListOptions) error); ok {
+		r1 = rf(public, opt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -118,13 +118,13 @@ func (_m *ImagesService) ListApplication(public bool) (do.Images, error) {
 	return r0, r1
 }
 
-// ListDistribution provides a mock function with given fields: public
-func (_m *ImagesService) ListDistribution(public bool) (do.Images, error) {
-	ret := _m.Called(public)
+// ListDistribution provides a mock function with given fields: public, opt
+func (_m *ImagesService) ListDistribution(public bool, opt *godo.ListOptions) (do.Images, error) {
+	ret := _m.Called(public, opt)
 
 	var r0 do.Images
-	if rf, ok := ret.Get(0).(func(bool) do.Images); ok {
-		r0 = rf(public)
+	if rf, ok := ret.Get(0).(func(bool, *godo.ListOptions) do.Images); ok {
+		r0 = rf(public, opt)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Images)
@@ -132,8 +132,8 @@ func (_m *ImagesService) ListDistribution(public bool) (do.Images, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(bool) error); ok {
-		r1 = rf(public)
+	if rf, ok := ret.Get(1).(func(bool, *godo.ListOptions) error); ok {
+		r1 = rf(public, opt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -141,13 +141,13 @@ func (_m *ImagesService) ListDistribution(public bool) (do.Images, error) {
 	return r0, r1
 }
 
-// ListUser provides a mock function with given fields: public
-func (_m *ImagesService) ListUser(public bool) (do.Images, error) {
-	ret := _m.Called(public)
+// ListUser provides a mock function with given fields: public, opt
+func (_m *ImagesService) ListUser(public bool, opt *godo.ListOptions) (do.Images, error) {
+	ret := _m.Called(public, opt)
 
 	var r0 do.Images
-	if rf, ok := ret.Get(0).(func(bool) do.Images); ok {
-		r0 = rf(public)
+	if rf, ok := ret.Get(0).(func(bool, *godo.ListOptions) do.Images); ok {
+		r0 = rf(public, opt)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Images)
@@ -155,8 +155,8 @@ func (_m *ImagesService) ListUser(public bool) (do.Images, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(bool) error); ok {
-		r1 = rf(public)
+	if rf, ok := ret.Get(1).(func(bool, *godo.ListOptions) error); ok {
+		r1 = rf(public, opt)
 	} else {
 		r1 = ret.Error(1)
 	}
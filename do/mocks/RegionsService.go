@@ -3,6 +3,8 @@ package mocks
 import "github.com/digitalocean/doctl/do"
 import "github.com/stretchr/testify/mock"
 
+import "github.com/digitalocean/godo"
+
 // Generated: please do not edit by hand
 
 // RegionsService is an autogenerated mock type for the RegionsService type
@@ -10,13 +12,13 @@ type RegionsService struct {
 	mock.Mock
 }
 
-// List provides a mock function with given fields:
-func (_m *RegionsService) List() (do.Regions, error) {
-	ret := _m.Called()
+// List provides a mock function with given fields: _a0
+func (_m *RegionsService) List(_a0 *godo.ListOptions) (do.Regions, error) {
+	ret := _m.Called(_a0)
 
 	var r0 do.Regions
-	if rf, ok := ret.Get(0).(func() do.Regions); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*godo.ListOptions) do.Regions); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Regions)
@@ -24,8 +26,8 @@ func (_m *RegionsService) List() (do.Regions, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*godo.ListOptions) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
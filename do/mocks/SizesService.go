@@ -3,6 +3,8 @@ package mocks
 import "github.com/digitalocean/doctl/do"
 import "github.com/stretchr/testify/mock"
 
+import "github.com/digitalocean/godo"
+
 // Generated: please do not edit by hand
 
 // SizesService is an autogenerated mock type for the SizesService type
@@ -10,13 +12,13 @@ type SizesService struct {
 	mock.Mock
 }
 
-// List provides a mock function with given fields:
-func (_m *SizesService) List() (do.Sizes, error) {
-	ret := _m.Called()
+// List provides a mock function with given fields: _a0
+func (_m *SizesService) List(_a0 *godo.ListOptions) (do.Sizes, error) {
+	ret := _m.Called(_a0)
 
 	var r0 do.Sizes
-	if rf, ok := ret.Get(0).(func() do.Sizes); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*godo.ListOptions) do.Sizes); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Sizes)
@@ -24,8 +26,8 @@ func (_m *SizesService) List() (do.Sizes, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*godo.ListOptions) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
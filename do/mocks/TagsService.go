@@ -72,13 +72,13 @@ func (_m *TagsService) Get(_a0 string) (*do.Tag, error) {
 	return r0, r1
 }
 
-// List provides a mock function with given fields:
-func (_m *TagsService) List() (do.Tags, error) {
-	ret := _m.Called()
+// List provides a mock function with given fields: _a0
+func (_m *TagsService) List(_a0 *godo.ListOptions) (do.Tags, error) {
+	ret := _m.Called(_a0)
 
 	var r0 do.Tags
-	if rf, ok := ret.Get(0).(func() do.Tags); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*godo.ListOptions) do.Tags); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(do.Tags)
@@ -86,8 +86,8 @@ func (_m *TagsService) List() (do.Tags, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*godo.ListOptions) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,6 +35,52 @@ func (_m *VolumesService) CreateVolume(_a0 *godo.VolumeCreateRequest) (*do.Volum
 	return r0, r1
 }
 
+// CreateSnapshot provides a mock function with given fields: _a0
+func (_m *VolumesService) CreateSnapshot(_a0 *godo.SnapshotCreateRequest) (*do.Snapshot, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *do.Snapshot
+	if rf, ok := ret.Get(0).(func(*godo.SnapshotCreateRequest) *do.Snapshot); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*do.Snapshot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*godo.SnapshotCreateRequest) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateVolumeFromSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *VolumesService) CreateVolumeFromSnapshot(_a0 *godo.VolumeCreateRequest, _a1 string) (*do.Volume, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *do.Volume
+	if rf, ok := ret.Get(0).(func(*godo.VolumeCreateRequest, string) *do.Volume); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*do.Volume)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*godo.VolumeCreateRequest, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteVolume provides a mock function with given fields: _a0
 func (_m *VolumesService) DeleteVolume(_a0 string) error {
 	ret := _m.Called(_a0)
@@ -72,19 +118,42 @@ func (_m *VolumesService) Get(_a0 string) (*do.Volume, error) {
 	return r0, r1
 }
 
-// List provides a mock function with given fields:
-func (_m *VolumesService) List() ([]do.Volume, error) {
-	ret := _m.Called()
+// List provides a mock function with given fields: _a0
+func (_m *VolumesService) List(_a0 *godo.ListOptions) ([]do.Volume, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []do.Volume
-	if rf, ok := ret.Get(0).(func() []do.Volume); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*godo.ListOptions) []do.Volume); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]do.Volume)
 		}
 	}
 
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*godo.ListOptions) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSnapshots provides a mock function with given fields:
+func (_m *VolumesService) ListSnapshots() ([]do.VolumeSnapshot, error) {
+	ret := _m.Called()
+
+	var r0 []do.VolumeSnapshot
+	if rf, ok := ret.Get(0).(func() []do.VolumeSnapshot); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]do.VolumeSnapshot)
+		}
+	}
+
 	var r1 error
 	if rf, ok := ret.Get(1).(func() error); ok {
 		r1 = rf()
@@ -43,8 +43,13 @@ func (pl *paginatedList) append(items ...interface{}) {
 // Generator is a function that generates the list to be paginated.
 type Generator func(*godo.ListOptions) ([]interface{}, *godo.Response, error)
 
-// PaginateResp paginates a Response.
-func PaginateResp(gen Generator) ([]interface{}, error) {
+// PaginateResp paginates a Response. If selected is nil every page is
+// fetched, otherwise only the page it selects.
+func PaginateResp(gen Generator, selected *godo.ListOptions) ([]interface{}, error) {
+	if selected != nil {
+		return fetchSelectedPage(gen, selected)
+	}
+
 	opt := &godo.ListOptions{Page: 1, PerPage: perPage}
 
 	l := paginatedList{}
@@ -91,6 +96,21 @@ func PaginateResp(gen Generator) ([]interface{}, error) {
 	return l.list, nil
 }
 
+// fetchSelectedPage fetches the single page selected. A zero page or
+// page size uses the first page or the default page size.
+func fetchSelectedPage(gen Generator, selected *godo.ListOptions) ([]interface{}, error) {
+	opt := &godo.ListOptions{Page: selected.Page, PerPage: selected.PerPage}
+	if opt.Page == 0 {
+		opt.Page = 1
+	}
+	if opt.PerPage == 0 {
+		opt.PerPage = perPage
+	}
+
+	items, _, err := gen(opt)
+	return items, err
+}
+
 func fetchPage(gen Generator, page int) ([]interface{}, error) {
 	opt := &godo.ListOptions{Page: page, PerPage: 200}
 	items, _, err := gen(opt)
@@ -33,12 +33,39 @@ func Test_PaginateResp(t *testing.T) {
 		return []interface{}{currentPage}, resp, nil
 	}
 
-	list, err := PaginateResp(gen)
+	list, err := PaginateResp(gen, nil)
 	assert.NoError(t, err)
 
 	assert.Len(t, list, 5)
 }
 
+func Test_PaginateResp_selectedPage(t *testing.T) {
+	cases := []struct {
+		page, perPage         int
+		wantPage, wantPerPage int
+	}{
+		{page: 3, perPage: 20, wantPage: 3, wantPerPage: 20},
+		{page: 2, wantPage: 2, wantPerPage: perPage},
+		{perPage: 5, wantPage: 1, wantPerPage: 5},
+	}
+
+	for _, c := range cases {
+		calls := 0
+		gen := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+			calls++
+			assert.Equal(t, c.wantPage, opt.Page)
+			assert.Equal(t, c.wantPerPage, opt.PerPage)
+			resp := &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Last: "http://example.com/?page=5"}}}
+			return []interface{}{opt.Page}, resp, nil
+		}
+
+		list, err := PaginateResp(gen, &godo.ListOptions{Page: c.page, PerPage: c.perPage})
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{c.wantPage}, list)
+		assert.Equal(t, 1, calls)
+	}
+}
+
 func Test_Pagination_fetchPage(t *testing.T) {
 	gen := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		items := []interface{}{}
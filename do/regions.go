@@ -26,7 +26,7 @@ type Regions []Region
 
 // RegionsService is the godo RegionsService interface.
 type RegionsService interface {
-	List() (Regions, error)
+	List(*godo.ListOptions) (Regions, error)
 }
 
 type regionsService struct {
@@ -42,7 +42,7 @@ func NewRegionsService(client *godo.Client) RegionsService {
 	}
 }
 
-func (rs *regionsService) List() (Regions, error) {
+func (rs *regionsService) List(opt *godo.ListOptions) (Regions, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := rs.client.Regions.List(opt)
 		if err != nil {
@@ -57,7 +57,7 @@ func (rs *regionsService) List() (Regions, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -26,7 +26,7 @@ type Sizes []Size
 
 // SizesService is the godo SizesService interface.
 type SizesService interface {
-	List() (Sizes, error)
+	List(*godo.ListOptions) (Sizes, error)
 }
 
 type sizesService struct {
@@ -42,7 +42,7 @@ func NewSizesService(client *godo.Client) SizesService {
 	}
 }
 
-func (rs *sizesService) List() (Sizes, error) {
+func (rs *sizesService) List(opt *godo.ListOptions) (Sizes, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := rs.client.Sizes.List(opt)
 		if err != nil {
@@ -57,7 +57,7 @@ func (rs *sizesService) List() (Sizes, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
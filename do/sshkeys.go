@@ -30,7 +30,7 @@ type SSHKeys []SSHKey
 
 // KeysService is the godo KeysService interface.
 type KeysService interface {
-	List() (SSHKeys, error)
+	List(*godo.ListOptions) (SSHKeys, error)
 	Get(id string) (*SSHKey, error)
 	Create(kcr *godo.KeyCreateRequest) (*SSHKey, error)
 	Update(id string, kur *godo.KeyUpdateRequest) (*SSHKey, error)
@@ -50,7 +50,7 @@ func NewKeysService(client *godo.Client) KeysService {
 	}
 }
 
-func (ks *keysService) List() (SSHKeys, error) {
+func (ks *keysService) List(opt *godo.ListOptions) (SSHKeys, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := ks.client.Keys.List(opt)
 		if err != nil {
@@ -65,7 +65,7 @@ func (ks *keysService) List() (SSHKeys, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
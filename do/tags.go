@@ -25,7 +25,7 @@ type Tags []Tag
 
 // TagsService is an interface for interacting with DigitalOcean's tags api.
 type TagsService interface {
-	List() (Tags, error)
+	List(*godo.ListOptions) (Tags, error)
 	Get(string) (*Tag, error)
 	Create(*godo.TagCreateRequest) (*Tag, error)
 	Update(string, *godo.TagUpdateRequest) error
@@ -47,7 +47,7 @@ func NewTagsService(godoClient *godo.Client) TagsService {
 	}
 }
 
-func (ts *tagsService) List() (Tags, error) {
+func (ts *tagsService) List(opt *godo.ListOptions) (Tags, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := ts.client.Tags.List(opt)
 		if err != nil {
@@ -62,7 +62,7 @@ func (ts *tagsService) List() (Tags, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 	}
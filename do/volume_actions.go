@@ -6,6 +6,8 @@ import "github.com/digitalocean/godo"
 type VolumeActionsService interface {
 	Attach(string, int) (*Action, error)
 	Detach(string) (*Action, error)
+	Resize(string, int, string) (*Action, error)
+	Get(string, int) (*Action, error)
 }
 
 type volumeActionsService struct {
@@ -41,3 +43,13 @@ func (das *volumeActionsService) Detach(volumeID string) (*Action, error) {
 	return das.handleActionResponse(a, err)
 
 }
+
+func (das *volumeActionsService) Resize(volumeID string, size int, regionSlug string) (*Action, error) {
+	a, _, err := das.client.StorageActions.Resize(volumeID, size, regionSlug)
+	return das.handleActionResponse(a, err)
+}
+
+func (das *volumeActionsService) Get(volumeID string, actionID int) (*Action, error) {
+	a, _, err := das.client.StorageActions.Get(volumeID, actionID)
+	return das.handleActionResponse(a, err)
+}
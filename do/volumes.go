@@ -1,18 +1,46 @@
 package do
 
-import "github.com/digitalocean/godo"
+import (
+	"fmt"
+
+	"github.com/digitalocean/godo"
+)
 
 // Volume is a wrapper for godo.Volume.
 type Volume struct {
 	*godo.Volume
 }
 
+// Snapshot is a wrapper for godo.Snapshot.
+type Snapshot struct {
+	*godo.Snapshot
+}
+
+// VolumeSnapshot is a volume snapshot as listed by the snapshots api. It
+// differs from Snapshot in carrying the regions and minimum disk size
+// needed to create a volume from it.
+type VolumeSnapshot struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Regions       []string `json:"regions"`
+	MinDiskSize   int      `json:"min_disk_size"`
+	SizeGigaBytes float64  `json:"size_gigabytes"`
+}
+
+type volumeSnapshotsRoot struct {
+	Snapshots []VolumeSnapshot `json:"snapshots"`
+	Links     *godo.Links      `json:"links"`
+}
+
 // VolumesService is an interface for interacting with DigitalOcean's account api.
 type VolumesService interface {
-	List() ([]Volume, error)
+	List(*godo.ListOptions) ([]Volume, error)
 	CreateVolume(*godo.VolumeCreateRequest) (*Volume, error)
+	CreateVolumeFromSnapshot(*godo.VolumeCreateRequest, string) (*Volume, error)
 	DeleteVolume(string) error
 	Get(string) (*Volume, error)
+	CreateSnapshot(*godo.SnapshotCreateRequest) (*Snapshot, error)
+	ListSnapshots() ([]VolumeSnapshot, error)
 }
 
 type volumesService struct {
@@ -29,7 +57,7 @@ func NewVolumesService(godoClient *godo.Client) VolumesService {
 
 }
 
-func (a *volumesService) List() ([]Volume, error) {
+func (a *volumesService) List(opt *godo.ListOptions) ([]Volume, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		list, resp, err := a.client.Storage.ListVolumes(opt)
 		if err != nil {
@@ -46,7 +74,7 @@ func (a *volumesService) List() ([]Volume, error) {
 
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(f, opt)
 	if err != nil {
 		return nil, err
 
@@ -73,6 +101,28 @@ func (a *volumesService) CreateVolume(r *godo.VolumeCreateRequest) (*Volume, err
 
 }
 
+func (a *volumesService) CreateVolumeFromSnapshot(r *godo.VolumeCreateRequest, snapshotID string) (*Volume, error) {
+	// godo's create request has no snapshot field, so the request is built here.
+	body := &struct {
+		*godo.VolumeCreateRequest
+		SnapshotID string `json:"snapshot_id"`
+	}{r, snapshotID}
+
+	req, err := a.client.NewRequest("POST", "v2/volumes", body)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(struct {
+		Volume *godo.Volume `json:"volume"`
+	})
+	if _, err := a.client.Do(req, root); err != nil {
+		return nil, err
+	}
+
+	return &Volume{Volume: root.Volume}, nil
+}
+
 func (a *volumesService) DeleteVolume(id string) error {
 
 	_, err := a.client.Storage.DeleteVolume(id)
@@ -95,3 +145,56 @@ func (a *volumesService) Get(id string) (*Volume, error) {
 	return &Volume{Volume: d}, nil
 
 }
+
+func (a *volumesService) CreateSnapshot(r *godo.SnapshotCreateRequest) (*Snapshot, error) {
+	// Volume snapshots are only available through godo's beta storage service.
+	bs, ok := a.client.Storage.(godo.BetaStorageService)
+	if !ok {
+		return nil, fmt.Errorf("volume snapshots are not supported by this client")
+	}
+
+	s, _, err := bs.CreateSnapshot(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{Snapshot: s}, nil
+}
+
+func (a *volumesService) ListSnapshots() ([]VolumeSnapshot, error) {
+	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		path := fmt.Sprintf("v2/snapshots?resource_type=volume&page=%d&per_page=%d", opt.Page, opt.PerPage)
+		req, err := a.client.NewRequest("GET", path, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		root := new(volumeSnapshotsRoot)
+		resp, err := a.client.Do(req, root)
+		if err != nil {
+			return nil, nil, err
+		}
+		if l := root.Links; l != nil {
+			resp.Links = l
+		}
+
+		si := make([]interface{}, len(root.Snapshots))
+		for i := range root.Snapshots {
+			si[i] = root.Snapshots[i]
+		}
+
+		return si, resp, err
+	}
+
+	si, err := PaginateResp(f, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]VolumeSnapshot, len(si))
+	for i := range si {
+		list[i] = si[i].(VolumeSnapshot)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package do
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolumesServiceCreateVolumeFromSnapshot(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v2/volumes", r.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "restored", body["name"])
+		assert.Equal(t, "nyc1", body["region"])
+		assert.Equal(t, float64(10), body["size_gigabytes"])
+		assert.Equal(t, "snap-1", body["snapshot_id"])
+
+		fmt.Fprint(w, `{"volume": {"id": "vol-1", "name": "restored"}}`)
+	}))
+	defer server.Close()
+
+	client := godo.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	vs := NewVolumesService(client)
+
+	v, err := vs.CreateVolumeFromSnapshot(&godo.VolumeCreateRequest{
+		Name: "restored", Region: "nyc1", SizeGigaBytes: 10,
+	}, "snap-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "vol-1", v.ID)
+}
+
+func TestVolumesServiceListSnapshots(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/snapshots", r.URL.Path)
+		assert.Equal(t, "volume", r.URL.Query().Get("resource_type"))
+		fmt.Fprint(w, `{"snapshots": [{"id": "snap-1", "name": "db", "regions": ["nyc1"], "min_disk_size": 10}]}`)
+	}))
+	defer server.Close()
+
+	client := godo.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	vs := NewVolumesService(client)
+
+	list, err := vs.ListSnapshots()
+	assert.NoError(t, err)
+	assert.Equal(t, []VolumeSnapshot{{ID: "snap-1", Name: "db", Regions: []string{"nyc1"}, MinDiskSize: 10}}, list)
+}
@@ -18,8 +18,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -36,6 +38,9 @@ const (
 	// NSRoot is a configuration key that signifies this value is at the root.
 	NSRoot = "doctl"
 
+	// DefaultAPIURL is the base URL of the public DigitalOcean API.
+	DefaultAPIURL = "https://api.digitalocean.com/"
+
 	// LatestReleaseURL is the latest release URL endpoint.
 	LatestReleaseURL = "https://api.github.com/repos/digitalocean/doctl/releases/latest"
 )
@@ -160,6 +165,7 @@ type Config interface {
 	GetBool(ns, key string) (bool, error)
 	GetInt(ns, key string) (int, error)
 	GetStringSlice(ns, key string) ([]string, error)
+	IsSet(ns, key string) bool
 }
 
 // LiveConfig is an implementation of Config for live values.
@@ -171,7 +177,11 @@ var _ Config = &LiveConfig{}
 
 // GetGodoClient returns a GodoClient.
 func (c *LiveConfig) GetGodoClient(trace bool) (*godo.Client, error) {
-	token := viper.GetString("access-token")
+	token, err := AccessToken()
+	if err != nil {
+		return nil, err
+	}
+
 	if token == "" {
 		return nil, fmt.Errorf("access token is required. (hint: run 'doctl auth init')")
 	}
@@ -180,7 +190,14 @@ func (c *LiveConfig) GetGodoClient(trace bool) (*godo.Client, error) {
 	oauthClient := oauth2.NewClient(oauth2.NoContext, tokenSource)
 
 	if trace {
-		r := newRecorder(oauthClient.Transport)
+		// trace below the oauth2 transport so the Authorization header it
+		// adds is visible (and masked) in the output.
+		ot := oauthClient.Transport.(*oauth2.Transport)
+		base := ot.Base
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		r := newRecorder(base)
 
 		go func() {
 			for {
@@ -193,10 +210,19 @@ func (c *LiveConfig) GetGodoClient(trace bool) (*godo.Client, error) {
 			}
 		}()
 
-		oauthClient.Transport = r
+		ot.Base = r
+	}
+
+	if retryMax := viper.GetInt("http-retry-max"); retryMax > 0 {
+		oauthClient.Transport = newRetryTransport(oauthClient.Transport, retryMax)
 	}
 
-	godoClient, err := godo.New(oauthClient, godo.SetUserAgent(userAgent()))
+	apiURL, err := parseAPIURL(viper.GetString("api-url"))
+	if err != nil {
+		return nil, err
+	}
+
+	godoClient, err := godo.New(oauthClient, godo.SetUserAgent(userAgent()), godo.SetBaseURL(apiURL))
 	if err != nil {
 		return nil, err
 	}
@@ -205,18 +231,66 @@ func (c *LiveConfig) GetGodoClient(trace bool) (*godo.Client, error) {
 	return c.godoClient, nil
 }
 
+// AccessToken returns the access token for the auth context selected with
+// --context, or the default access token if no context was selected.
+func AccessToken() (string, error) {
+	context := viper.GetString("context")
+	if context == "" || context == "default" {
+		return viper.GetString("access-token"), nil
+	}
+
+	tokens := viper.GetStringMapString("auth-contexts")
+	token, ok := tokens[context]
+	if !ok {
+		return "", fmt.Errorf("auth context %q does not exist", context)
+	}
+
+	return token, nil
+}
+
+// parseAPIURL validates the API base URL, falling back to the public API
+// when none is set. A trailing slash is added so request paths resolve
+// below the URL's path rather than replacing its last segment.
+func parseAPIURL(s string) (string, error) {
+	if s == "" {
+		return DefaultAPIURL, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid api url %q: %v", s, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid api url %q: must be an absolute http or https url", s)
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+
+	return u.String(), nil
+}
+
 func userAgent() string {
 	return "doctl/" + DoitVersion.String()
 }
 
 // SSH creates a ssh connection to a host.
 func (c *LiveConfig) SSH(user, host, keyPath string, port int, opts ssh.Options) runner.Runner {
+	command, _ := opts[ArgSSHCommand].(string)
+	stdin, _ := opts[ssh.OptStdin].(io.Reader)
+	stdout, _ := opts[ssh.OptStdout].(io.Writer)
+	stderr, _ := opts[ssh.OptStderr].(io.Writer)
+
 	return &ssh.Runner{
 		User:            user,
 		Host:            host,
 		KeyPath:         keyPath,
 		Port:            port,
 		AgentForwarding: opts[ArgsSSHAgentForwarding].(bool),
+		Command:         command,
+		Stdin:           stdin,
+		Stdout:          stdout,
+		Stderr:          stderr,
 	}
 }
 
@@ -226,6 +300,15 @@ func (c *LiveConfig) Set(ns, key string, val interface{}) {
 	viper.Set(nskey, val)
 }
 
+// IsSet returns true if a config value was explicitly provided.
+func (c *LiveConfig) IsSet(ns, key string) bool {
+	if ns == NSRoot {
+		return viper.IsSet(key)
+	}
+
+	return viper.IsSet(fmt.Sprintf("%s.%s", ns, key))
+}
+
 // GetString returns a config value as a string.
 func (c *LiveConfig) GetString(ns, key string) (string, error) {
 	if ns == NSRoot {
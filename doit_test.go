@@ -17,6 +17,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -106,3 +107,61 @@ type stubLatestRelease struct {
 func (slr stubLatestRelease) LatestVersion() (string, error) {
 	return slr.version, nil
 }
+
+func TestAccessToken(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set("access-token", "default-token")
+	viper.Set("auth-contexts", map[string]interface{}{"ci": "ci-token"})
+
+	token, err := AccessToken()
+	assert.NoError(t, err)
+	assert.Equal(t, "default-token", token)
+
+	viper.Set("context", "ci")
+	token, err = AccessToken()
+	assert.NoError(t, err)
+	assert.Equal(t, "ci-token", token)
+
+	viper.Set("context", "missing")
+	_, err = AccessToken()
+	assert.EqualError(t, err, `auth context "missing" does not exist`)
+}
+
+func TestParseAPIURL(t *testing.T) {
+	cases := []struct {
+		in, out, err string
+	}{
+		{in: "", out: DefaultAPIURL},
+		{in: "http://localhost:8080", out: "http://localhost:8080/"},
+		{in: "https://proxy.example.com/do/", out: "https://proxy.example.com/do/"},
+		{in: "https://proxy.example.com/do", out: "https://proxy.example.com/do/"},
+		{in: "localhost:8080", err: `invalid api url "localhost:8080": must be an absolute http or https url`},
+		{in: "ftp://example.com", err: `invalid api url "ftp://example.com": must be an absolute http or https url`},
+	}
+
+	for _, c := range cases {
+		got, err := parseAPIURL(c.in)
+		if c.err != "" {
+			assert.EqualError(t, err, c.err, c.in)
+			continue
+		}
+		assert.NoError(t, err, c.in)
+		assert.Equal(t, c.out, got, c.in)
+	}
+}
+
+func TestGetGodoClientAPIURL(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set("access-token", "token")
+	viper.Set("api-url", "http://localhost:8080")
+
+	client, err := (&LiveConfig{}).GetGodoClient(false)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/", client.BaseURL.String())
+
+	viper.Set("api-url", "localhost:8080")
+	_, err = (&LiveConfig{}).GetGodoClient(false)
+	assert.Error(t, err)
+}
@@ -14,6 +14,9 @@ limitations under the License.
 package ssh
 
 import (
+	"fmt"
+	"io"
+	"os"
 	"runtime"
 
 	"github.com/digitalocean/doctl/pkg/runner"
@@ -22,6 +25,15 @@ import (
 // Options is the type used to specify options passed to the SSH command
 type Options map[string]interface{}
 
+const (
+	// OptStdin is the option for the reader used as the session's stdin.
+	OptStdin = "stdin"
+	// OptStdout is the option for the writer used as the session's stdout.
+	OptStdout = "stdout"
+	// OptStderr is the option for the writer used as the session's stderr.
+	OptStderr = "stderr"
+)
+
 // Runner runs ssh commands.
 type Runner struct {
 	User            string
@@ -29,12 +41,38 @@ type Runner struct {
 	KeyPath         string
 	Port            int
 	AgentForwarding bool
+	Command         string
+
+	// Stdin, Stdout and Stderr default to the process's standard streams.
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// ExitError is returned when a remote command exits with a non-zero status.
+type ExitError struct {
+	Status int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("remote command exited with status %d", e.Status)
 }
 
 var _ runner.Runner = &Runner{}
 
-// Run ssh.
+// Run ssh. If Command is set it is run on the remote host instead of an
+// interactive shell.
 func (r *Runner) Run() error {
+	if r.Stdin == nil {
+		r.Stdin = os.Stdin
+	}
+	if r.Stdout == nil {
+		r.Stdout = os.Stdout
+	}
+	if r.Stderr == nil {
+		r.Stderr = os.Stderr
+	}
+
 	if runtime.GOOS == "windows" {
 		return runInternalSSH(r)
 	}
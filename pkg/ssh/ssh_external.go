@@ -14,9 +14,9 @@ limitations under the License.
 package ssh
 
 import (
-	"os"
 	"os/exec"
 	"strconv"
+	"syscall"
 )
 
 func runExternalSSH(r *Runner) error {
@@ -40,11 +40,22 @@ func runExternalSSH(r *Runner) error {
 
 	args = append(args, sshHost)
 
+	if r.Command != "" {
+		args = append(args, r.Command)
+	}
+
 	cmd := exec.Command("ssh", args...)
 
-	cmd.Stderr = os.Stderr
-	cmd.Stdout = os.Stdout
-	cmd.Stdin = os.Stdin
+	cmd.Stderr = r.Stderr
+	cmd.Stdout = r.Stdout
+	cmd.Stdin = r.Stdin
+
+	err := cmd.Run()
+	if ee, ok := err.(*exec.ExitError); ok && r.Command != "" {
+		if ws, ok := ee.Sys().(syscall.WaitStatus); ok {
+			return &ExitError{Status: ws.ExitStatus()}
+		}
+	}
 
-	return cmd.Run()
+	return err
 }
@@ -49,9 +49,9 @@ func askForPassword(prompt string) (string, error) {
 	return password, nil
 }
 
-func sshConnect(user string, host string, method ssh.AuthMethod, a agent.Agent) error {
+func sshConnect(r *Runner, host string, method ssh.AuthMethod, a agent.Agent) error {
 	sshc := &ssh.ClientConfig{
-		User: user,
+		User: r.User,
 		Auth: []ssh.AuthMethod{method},
 	}
 	conn, err := ssh.Dial("tcp", host, sshc)
@@ -70,9 +70,9 @@ func sshConnect(user string, host string, method ssh.AuthMethod, a agent.Agent)
 		_ = session.Close()
 	}()
 
-	session.Stdout = os.Stdout
-	session.Stderr = os.Stderr
-	session.Stdin = os.Stdin
+	session.Stdout = r.Stdout
+	session.Stderr = r.Stderr
+	session.Stdin = r.Stdin
 
 	if a != nil {
 		if err := agent.RequestAgentForwarding(session); err != nil {
@@ -84,6 +84,14 @@ func sshConnect(user string, host string, method ssh.AuthMethod, a agent.Agent)
 		}
 	}
 
+	if r.Command != "" {
+		err := session.Run(r.Command)
+		if ee, ok := err.(*ssh.ExitError); ok {
+			return &ExitError{Status: ee.ExitStatus()}
+		}
+		return err
+	}
+
 	fd := int(os.Stdin.Fd())
 
 	oldState, err := terminal.MakeRaw(fd)
@@ -193,7 +201,11 @@ func runInternalSSH(r *Runner) error {
 			}
 		}
 
-		if err := sshConnect(r.User, sshHost, ssh.PublicKeys(s), a); err != nil {
+		err = sshConnect(r, sshHost, ssh.PublicKeys(s), a)
+		if _, ok := err.(*ExitError); ok {
+			return err
+		}
+		if err != nil {
 			shouldTryPasswordMethod = true
 		}
 	} else {
@@ -207,7 +219,7 @@ func runInternalSSH(r *Runner) error {
 		if err != nil {
 			return err
 		}
-		if err := sshConnect(r.User, sshHost, ssh.Password(string(password)), nil); err != nil {
+		if err := sshConnect(r, sshHost, ssh.Password(string(password)), nil); err != nil {
 			return err
 		}
 	}
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httputil"
+	"strings"
 )
 
 // recorder traces http connections. It sends the output to a request and
@@ -36,13 +37,20 @@ func newRecorder(transport http.RoundTripper) *recorder {
 }
 
 func (rec *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
-	reqBytes, err := httputil.DumpRequestOut(req, true)
+	dump := maskRequest(req)
+	reqBytes, err := httputil.DumpRequestOut(dump, true)
 	if err != nil {
 		return nil, fmt.Errorf("transport.Recorder: dumping request, %v", err)
 	}
+	// dumping replaces the body of the dumped request.
+	req.Body = dump.Body
 	rec.req <- string(reqBytes)
 
 	resp, rerr := rec.wrap.RoundTrip(req)
+	if rerr != nil {
+		rec.resp <- rerr.Error()
+		return resp, rerr
+	}
 
 	respBytes, err := httputil.DumpResponse(resp, true)
 	if err != nil {
@@ -50,5 +58,29 @@ func (rec *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
 	}
 	rec.resp <- string(respBytes)
 
-	return resp, rerr
+	return resp, nil
+}
+
+// maskRequest returns a shallow copy of req with the credentials in the
+// Authorization header masked, so they do not appear in trace output.
+func maskRequest(req *http.Request) *http.Request {
+	auth := req.Header.Get("Authorization")
+	if auth == "" {
+		return req
+	}
+
+	masked := *req
+	masked.Header = make(http.Header, len(req.Header))
+	for k, v := range req.Header {
+		masked.Header[k] = v
+	}
+
+	if i := strings.Index(auth, " "); i >= 0 {
+		auth = auth[:i+1] + "[REDACTED]"
+	} else {
+		auth = "[REDACTED]"
+	}
+	masked.Header.Set("Authorization", auth)
+
+	return &masked
 }
@@ -0,0 +1,93 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestRecorderMasksAuthorization(t *testing.T) {
+	var sentAuth, sentBody string
+	rec := newRecorder(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		sentAuth = req.Header.Get("Authorization")
+		b, _ := ioutil.ReadAll(req.Body)
+		sentBody = string(b)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"ok":true}`)),
+		}, nil
+	}))
+
+	var reqOut, respOut string
+	done := make(chan struct{})
+	go func() {
+		reqOut = <-rec.req
+		respOut = <-rec.resp
+		close(done)
+	}()
+
+	req, err := http.NewRequest("POST", "https://api.digitalocean.com/v2/droplets", strings.NewReader(`{"name":"web"}`))
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret-token")
+
+	_, err = rec.RoundTrip(req)
+	assert.NoError(t, err)
+	<-done
+
+	assert.Contains(t, reqOut, "POST /v2/droplets")
+	assert.Contains(t, reqOut, "Authorization: Bearer [REDACTED]")
+	assert.NotContains(t, reqOut, "secret-token")
+	assert.Contains(t, respOut, "200 OK")
+
+	assert.Equal(t, "Bearer secret-token", sentAuth)
+	assert.Equal(t, `{"name":"web"}`, sentBody)
+}
+
+func TestRecorderTransportError(t *testing.T) {
+	rec := newRecorder(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}))
+
+	var respOut string
+	done := make(chan struct{})
+	go func() {
+		<-rec.req
+		respOut = <-rec.resp
+		close(done)
+	}()
+
+	req, err := http.NewRequest("GET", "https://api.digitalocean.com/v2/account", nil)
+	assert.NoError(t, err)
+
+	_, err = rec.RoundTrip(req)
+	assert.Error(t, err)
+	<-done
+
+	assert.Equal(t, "connection refused", respOut)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// maxRateLimitWait is the longest retryTransport waits for a rate limit to
+// reset. Beyond it the request fails rather than appearing to hang.
+const maxRateLimitWait = time.Minute
+
+// retryTransport retries requests which were rate limited, and idempotent
+// requests which failed with a server or transport error. It backs off
+// exponentially, waiting until the rate limit resets if the API says when that
+// will be.
+type retryTransport struct {
+	wrap    http.RoundTripper
+	max     int
+	backoff time.Duration
+	warn    io.Writer
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func newRetryTransport(transport http.RoundTripper, max int) *retryTransport {
+	return &retryTransport{
+		wrap:    transport,
+		max:     max,
+		backoff: time.Second,
+		warn:    os.Stderr,
+		now:     time.Now,
+		sleep:   time.Sleep,
+	}
+}
+
+func (rt *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// the body is buffered so every attempt can send it, and each attempt
+	// gets its own copy of req since a RoundTripper must not modify it.
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	for attempt := 0; ; attempt++ {
+		r := new(http.Request)
+		*r = *req
+		if req.Body != nil {
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := rt.wrap.RoundTrip(r)
+		if attempt >= rt.max || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		if err != nil {
+			wait := rt.backoff << uint(attempt)
+			fmt.Fprintf(rt.warn, "Warning: request failed: %v, retrying in %s (%d/%d)\n",
+				err, wait, attempt+1, rt.max)
+			rt.sleep(wait)
+			continue
+		}
+
+		wait, werr := rt.wait(resp, attempt)
+		if werr != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+			return nil, werr
+		}
+		fmt.Fprintf(rt.warn, "Warning: API responded with %s, retrying in %s (%d/%d)\n",
+			resp.Status, wait, attempt+1, rt.max)
+
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+
+		rt.sleep(wait)
+	}
+}
+
+// wait returns how long to wait before the next attempt. It fails if the rate
+// limit resets further away than maxRateLimitWait.
+func (rt *retryTransport) wait(resp *http.Response, attempt int) (time.Duration, error) {
+	wait := rt.backoff << uint(attempt)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if reset, err := strconv.ParseInt(resp.Header.Get("RateLimit-Reset"), 10, 64); err == nil {
+			d := time.Unix(reset, 0).Sub(rt.now())
+			if d > maxRateLimitWait {
+				return 0, fmt.Errorf("API rate limit exceeded, it resets in %s; try again later", d)
+			}
+			if d > wait {
+				wait = d
+			}
+		}
+	}
+
+	return wait, nil
+}
+
+// shouldRetry reports whether a request is worth sending again. Rate limited
+// requests were never processed, so they are retried whatever their method;
+// anything else only when repeating it cannot do something twice.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if !isIdempotent(req.Method) {
+		return false
+	}
+
+	return err != nil || resp.StatusCode >= 500
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE", "OPTIONS":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2016 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubTransport struct {
+	statuses []int
+	errs     []error
+	headers  http.Header
+	bodies   []string
+}
+
+func (st *stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		b, _ := ioutil.ReadAll(req.Body)
+		st.bodies = append(st.bodies, string(b))
+	}
+
+	if len(st.errs) > 0 {
+		err := st.errs[0]
+		st.errs = st.errs[1:]
+		return nil, err
+	}
+
+	status := st.statuses[0]
+	st.statuses = st.statuses[1:]
+
+	return &http.Response{
+		Status:     strconv.Itoa(status) + " " + http.StatusText(status),
+		StatusCode: status,
+		Header:     st.headers,
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}, nil
+}
+
+func newTestRetryTransport(st *stubTransport, max int) (*retryTransport, *[]time.Duration, *bytes.Buffer) {
+	var waits []time.Duration
+	var warn bytes.Buffer
+
+	rt := newRetryTransport(st, max)
+	rt.warn = &warn
+	rt.now = func() time.Time { return time.Unix(1000, 0) }
+	rt.sleep = func(d time.Duration) { waits = append(waits, d) }
+
+	return rt, &waits, &warn
+}
+
+func TestRetryTransport(t *testing.T) {
+	st := &stubTransport{statuses: []int{500, 502, 200}}
+	rt, waits, warn := newTestRetryTransport(st, 3)
+
+	req, err := http.NewRequest("PUT", "http://example.com", strings.NewReader("body"))
+	assert.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, *waits)
+	assert.Equal(t, []string{"body", "body", "body"}, st.bodies)
+	assert.Contains(t, warn.String(), "500 Internal Server Error")
+}
+
+func TestRetryTransport_DoesNotModifyRequest(t *testing.T) {
+	st := &stubTransport{statuses: []int{503, 200}}
+	rt, _, _ := newTestRetryTransport(st, 3)
+
+	// a plain io.Reader body, which http.NewRequest can't rewind by itself.
+	req, err := http.NewRequest("PUT", "http://example.com", struct{ io.Reader }{strings.NewReader("body")})
+	assert.NoError(t, err)
+	body := req.Body
+
+	resp, err := rt.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []string{"body", "body"}, st.bodies)
+	assert.True(t, body == req.Body)
+}
+
+func TestRetryTransport_ServerErrorNotIdempotent(t *testing.T) {
+	st := &stubTransport{statuses: []int{500, 200}}
+	rt, waits, _ := newTestRetryTransport(st, 3)
+
+	req, err := http.NewRequest("POST", "http://example.com", strings.NewReader("body"))
+	assert.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 500, resp.StatusCode)
+	assert.Empty(t, *waits)
+	assert.Equal(t, []string{"body"}, st.bodies)
+}
+
+func TestRetryTransport_RateLimitNotIdempotent(t *testing.T) {
+	st := &stubTransport{statuses: []int{429, 200}}
+	rt, waits, _ := newTestRetryTransport(st, 3)
+
+	req, err := http.NewRequest("POST", "http://example.com", strings.NewReader("body"))
+	assert.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []time.Duration{time.Second}, *waits)
+	assert.Equal(t, []string{"body", "body"}, st.bodies)
+}
+
+func TestRetryTransport_TransportError(t *testing.T) {
+	st := &stubTransport{statuses: []int{200}, errs: []error{errors.New("connection reset")}}
+	rt, waits, warn := newTestRetryTransport(st, 3)
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	assert.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []time.Duration{time.Second}, *waits)
+	assert.Contains(t, warn.String(), "connection reset")
+}
+
+func TestRetryTransport_TransportErrorNotIdempotent(t *testing.T) {
+	st := &stubTransport{statuses: []int{200}, errs: []error{errors.New("connection reset")}}
+	rt, waits, _ := newTestRetryTransport(st, 3)
+
+	req, err := http.NewRequest("POST", "http://example.com", nil)
+	assert.NoError(t, err)
+
+	_, err = rt.RoundTrip(req)
+	assert.EqualError(t, err, "connection reset")
+	assert.Empty(t, *waits)
+}
+
+func TestRetryTransport_GivesUp(t *testing.T) {
+	st := &stubTransport{statuses: []int{503, 503, 503}}
+	rt, waits, _ := newTestRetryTransport(st, 2)
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	assert.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Len(t, *waits, 2)
+}
+
+func TestRetryTransport_RateLimitReset(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("RateLimit-Reset", "1030")
+	st := &stubTransport{statuses: []int{429, 200}, headers: headers}
+	rt, waits, _ := newTestRetryTransport(st, 3)
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	assert.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []time.Duration{30 * time.Second}, *waits)
+}
+
+func TestRetryTransport_RateLimitResetTooFar(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("RateLimit-Reset", "4600")
+	st := &stubTransport{statuses: []int{429, 200}, headers: headers}
+	rt, waits, _ := newTestRetryTransport(st, 3)
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	assert.NoError(t, err)
+
+	_, err = rt.RoundTrip(req)
+	assert.EqualError(t, err, "API rate limit exceeded, it resets in 1h0m0s; try again later")
+	assert.Empty(t, *waits)
+}
+
+func TestRetryTransport_ClientError(t *testing.T) {
+	st := &stubTransport{statuses: []int{404}}
+	rt, waits, _ := newTestRetryTransport(st, 3)
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	assert.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 404, resp.StatusCode)
+	assert.Empty(t, *waits)
+}